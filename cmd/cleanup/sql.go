@@ -0,0 +1,36 @@
+package cleanup
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/kratos/cmd/cliclient"
+	"github.com/ory/x/configx"
+)
+
+// NewCleanupSQLCmd represents the cleanup sql command
+func NewCleanupSQLCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sql <database-url>",
+		Short: "Permanently delete soft-deleted identities and expired identity changes",
+		Long: `Permanently deletes identities which were soft-deleted longer ago than the retention period configured
+in identity.soft_delete.retention. Purged identities can no longer be restored.
+
+Also deletes recorded identity changes which are older than the retention period configured in
+identity.history.retention.
+
+It is recommended to run this command periodically, for example as a cron job, and close to the SQL instance
+(e.g. same subnet) instead of over the public internet.
+
+You can read in the database URL using the -e flag, for example:
+	export DSN=...
+	kratos cleanup sql -e
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cliclient.NewCleanupHandler().CleanupSQL(cmd, args)
+		},
+	}
+
+	configx.RegisterFlags(c.PersistentFlags())
+	c.Flags().BoolP("read-from-env", "e", false, "If set, reads the database connection string from the environment variable DSN or config file key dsn.")
+	return c
+}
@@ -0,0 +1,33 @@
+package cliclient
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/x/cmdx"
+)
+
+type CleanupHandler struct{}
+
+func NewCleanupHandler() *CleanupHandler {
+	return &CleanupHandler{}
+}
+
+func (h *CleanupHandler) CleanupSQL(cmd *cobra.Command, args []string) {
+	d := newRegistryFromDSN(cmd, args)
+
+	err := d.Init(cmd.Context())
+	cmdx.Must(err, "An error occurred initializing cleanup: %s", err)
+
+	retention := d.Config(cmd.Context()).IdentitySoftDeleteRetention()
+	count, err := d.PrivilegedIdentityPool().PurgeDeletedIdentities(cmd.Context(), time.Now().Add(-retention))
+	cmdx.Must(err, "An error occurred while purging deleted identities: %s", err)
+	fmt.Printf("Successfully purged %d identities which were deleted more than %s ago!\n", count, retention)
+
+	retention = d.Config(cmd.Context()).IdentityHistoryRetention()
+	count, err = d.PrivilegedIdentityPool().PurgeIdentityHistory(cmd.Context(), time.Now().Add(-retention))
+	cmdx.Must(err, "An error occurred while purging the identity change history: %s", err)
+	fmt.Printf("Successfully purged %d identity changes which were made more than %s ago!\n", count, retention)
+}
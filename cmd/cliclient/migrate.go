@@ -24,6 +24,35 @@ func NewMigrateHandler() *MigrateHandler {
 }
 
 func (h *MigrateHandler) MigrateSQL(cmd *cobra.Command, args []string) {
+	d := newRegistryFromDSN(cmd, args)
+
+	err := d.Init(cmd.Context(), driver.SkipNetworkInit)
+	cmdx.Must(err, "An error occurred initializing migrations: %s", err)
+
+	var plan bytes.Buffer
+	statuses, err := d.Persister().MigrationStatus(cmd.Context())
+	cmdx.Must(err, "An error occurred planning migrations:%s \n-- Migration Plan --\n%s", err, statuses.Write(&plan))
+
+	if !flagx.MustGetBool(cmd, "yes") {
+		fmt.Println("The following migration is planned:")
+		fmt.Println("")
+		fmt.Printf("%s", plan.String())
+		fmt.Println("")
+		fmt.Println("To skip the next question use flag --yes (at your own risk).")
+		if !askForConfirmation("Do you wish to execute this migration plan?") {
+			fmt.Println("Migration aborted.")
+			return
+		}
+	}
+
+	err = d.Persister().MigrateUp(cmd.Context())
+	cmdx.Must(err, "An error occurred while connecting to SQL: %s", err)
+	fmt.Println("Successfully applied SQL migrations!")
+}
+
+// newRegistryFromDSN returns an uninitialized registry for the database URL given as the only argument or, if
+// the -e flag is set, read from the environment or config file.
+func newRegistryFromDSN(cmd *cobra.Command, args []string) driver.Registry {
 	var d driver.Registry
 
 	if flagx.MustGetBool(cmd, "read-from-env") {
@@ -37,13 +66,13 @@ func (h *MigrateHandler) MigrateSQL(cmd *cobra.Command, args []string) {
 			fmt.Println("")
 			fmt.Println("When using flag -e, environment variable DSN must be set")
 			os.Exit(1)
-			return
+			return nil
 		}
 	} else {
 		if len(args) != 1 {
 			fmt.Println(cmd.UsageString())
 			os.Exit(1)
-			return
+			return nil
 		}
 		d = driver.NewWithoutInit(
 			cmd.Context(),
@@ -53,28 +82,7 @@ func (h *MigrateHandler) MigrateSQL(cmd *cobra.Command, args []string) {
 			configx.WithValue(config.ViperKeyDSN, args[0]))
 	}
 
-	err := d.Init(cmd.Context(), driver.SkipNetworkInit)
-	cmdx.Must(err, "An error occurred initializing migrations: %s", err)
-
-	var plan bytes.Buffer
-	statuses, err := d.Persister().MigrationStatus(cmd.Context())
-	cmdx.Must(err, "An error occurred planning migrations:%s \n-- Migration Plan --\n%s", err, statuses.Write(&plan))
-
-	if !flagx.MustGetBool(cmd, "yes") {
-		fmt.Println("The following migration is planned:")
-		fmt.Println("")
-		fmt.Printf("%s", plan.String())
-		fmt.Println("")
-		fmt.Println("To skip the next question use flag --yes (at your own risk).")
-		if !askForConfirmation("Do you wish to execute this migration plan?") {
-			fmt.Println("Migration aborted.")
-			return
-		}
-	}
-
-	err = d.Persister().MigrateUp(cmd.Context())
-	cmdx.Must(err, "An error occurred while connecting to SQL: %s", err)
-	fmt.Println("Successfully applied SQL migrations!")
+	return d
 }
 
 func askForConfirmation(s string) bool {
@@ -45,6 +45,7 @@ func init() {
 		"NewInfoNodeLabelSubmit":                     text.NewInfoNodeLabelSubmit(),
 		"NewInfoNodeLabelID":                         text.NewInfoNodeLabelID(),
 		"NewErrorValidationSettingsFlowExpired":      text.NewErrorValidationSettingsFlowExpired(time.Second),
+		"NewErrorValidationSettingsIdentityModified": text.NewErrorValidationSettingsIdentityModified(),
 		"NewInfoSelfServiceSettingsTOTPQRCode":       text.NewInfoSelfServiceSettingsTOTPQRCode(),
 		"NewInfoSelfServiceSettingsTOTPSecret":       text.NewInfoSelfServiceSettingsTOTPSecret("{secret}"),
 		"NewInfoSelfServiceSettingsTOTPSecretLabel":  text.NewInfoSelfServiceSettingsTOTPSecretLabel(),
@@ -69,6 +70,7 @@ func init() {
 		"NewErrorValidationVerificationFlowExpired":               text.NewErrorValidationVerificationFlowExpired(-time.Second),
 		"NewInfoSelfServiceVerificationSuccessful":                text.NewInfoSelfServiceVerificationSuccessful(),
 		"NewVerificationEmailSent":                                text.NewVerificationEmailSent(),
+		"NewVerificationSMSSent":                                  text.NewVerificationSMSSent(),
 		"NewErrorValidationVerificationTokenInvalidOrAlreadyUsed": text.NewErrorValidationVerificationTokenInvalidOrAlreadyUsed(),
 		"NewErrorValidationVerificationRetrySuccess":              text.NewErrorValidationVerificationRetrySuccess(),
 		"NewErrorValidationVerificationStateFailure":              text.NewErrorValidationVerificationStateFailure(),
@@ -80,14 +82,17 @@ func init() {
 		"NewErrorValidationPasswordPolicyViolation":               text.NewErrorValidationPasswordPolicyViolation("{reason}"),
 		"NewErrorValidationInvalidCredentials":                    text.NewErrorValidationInvalidCredentials(),
 		"NewErrorValidationDuplicateCredentials":                  text.NewErrorValidationDuplicateCredentials(),
+		"NewErrorValidationDuplicateTrait":                        text.NewErrorValidationDuplicateTrait("{value}"),
 		"NewErrorValidationTOTPVerifierWrong":                     text.NewErrorValidationTOTPVerifierWrong(),
 		"NewErrorValidationLookupAlreadyUsed":                     text.NewErrorValidationLookupAlreadyUsed(),
 		"NewErrorValidationLookupInvalid":                         text.NewErrorValidationLookupInvalid(),
+		"NewErrorValidationCodeInvalid":                           text.NewErrorValidationCodeInvalid(),
 		"NewErrorValidationIdentifierMissing":                     text.NewErrorValidationIdentifierMissing(),
 		"NewErrorValidationAddressNotVerified":                    text.NewErrorValidationAddressNotVerified(),
 		"NewErrorValidationNoTOTPDevice":                          text.NewErrorValidationNoTOTPDevice(),
 		"NewErrorValidationNoLookup":                              text.NewErrorValidationNoLookup(),
 		"NewErrorValidationNoWebAuthnDevice":                      text.NewErrorValidationNoWebAuthnDevice(),
+		"NewErrorValidationIdentityDisabled":                      text.NewErrorValidationIdentityDisabled(),
 		"NewInfoLoginReAuth":                                      text.NewInfoLoginReAuth(),
 		"NewInfoLoginMFA":                                         text.NewInfoLoginMFA(),
 		"NewInfoLoginTOTPLabel":                                   text.NewInfoLoginTOTPLabel(),
@@ -111,10 +116,13 @@ func init() {
 		"NewErrorValidationRecoveryFlowExpired":                   text.NewErrorValidationRecoveryFlowExpired(time.Second),
 		"NewRecoverySuccessful":                                   text.NewRecoverySuccessful(inAMinute),
 		"NewRecoveryEmailSent":                                    text.NewRecoveryEmailSent(),
+		"NewRecoverySMSSent":                                      text.NewRecoverySMSSent(),
 		"NewErrorValidationRecoveryTokenInvalidOrAlreadyUsed":     text.NewErrorValidationRecoveryTokenInvalidOrAlreadyUsed(),
 		"NewErrorValidationRecoveryRetrySuccess":                  text.NewErrorValidationRecoveryRetrySuccess(),
 		"NewErrorValidationRecoveryStateFailure":                  text.NewErrorValidationRecoveryStateFailure(),
 		"NewInfoNodeInputEmail":                                   text.NewInfoNodeInputEmail(),
+		"NewInfoNodeInputPhone":                                   text.NewInfoNodeInputPhone(),
+		"NewInfoNodeInputCode":                                    text.NewInfoNodeInputCode(),
 	}
 }
 
@@ -13,6 +13,7 @@ type (
 	outputIdentityCollection struct {
 		identities []kratos.Identity
 	}
+	outputSchemaMigrationReport kratos.IdentitySchemaMigrationReport
 )
 
 func (_ *outputIdentity) Header() []string {
@@ -91,3 +92,26 @@ func (c *outputIdentityCollection) Interface() interface{} {
 func (c *outputIdentityCollection) Len() int {
 	return len(c.identities)
 }
+
+func (_ *outputSchemaMigrationReport) Header() []string {
+	return []string{"IDENTITY ID", "ERROR"}
+}
+
+func (r *outputSchemaMigrationReport) Table() [][]string {
+	rows := make([][]string, len(r.Failures))
+	for i, f := range r.Failures {
+		rows[i] = []string{f.IdentityId, f.Error.Message}
+		if f.Error.Reason != nil {
+			rows[i][1] = *f.Error.Reason
+		}
+	}
+	return rows
+}
+
+func (r *outputSchemaMigrationReport) Interface() interface{} {
+	return r
+}
+
+func (r *outputSchemaMigrationReport) Len() int {
+	return len(r.Failures)
+}
@@ -33,7 +33,7 @@ func TestGetCmd(t *testing.T) {
 		ij, err := json.Marshal(i)
 		require.NoError(t, err)
 
-		assertx.EqualAsJSONExcept(t, json.RawMessage(ij), json.RawMessage(stdOut), []string{"created_at", "updated_at"})
+		assertx.EqualAsJSONExcept(t, json.RawMessage(ij), json.RawMessage(stdOut), []string{"created_at", "updated_at", "metadata_public"})
 	})
 
 	t.Run("case=gets three identities", func(t *testing.T) {
@@ -44,7 +44,7 @@ func TestGetCmd(t *testing.T) {
 		isj, err := json.Marshal(is)
 		require.NoError(t, err)
 
-		assertx.EqualAsJSONExcept(t, json.RawMessage(isj), json.RawMessage(stdOut), []string{"created_at", "updated_at"})
+		assertx.EqualAsJSONExcept(t, json.RawMessage(isj), json.RawMessage(stdOut), []string{"created_at", "updated_at", "0.metadata_public", "1.metadata_public", "2.metadata_public"})
 	})
 
 	t.Run("case=fails with unknown ID", func(t *testing.T) {
@@ -97,10 +97,10 @@ func TestGetCmd(t *testing.T) {
 		require.NoError(t, reg.Persister().CreateIdentity(context.Background(), i))
 
 		stdOut := execNoErr(t, c, i.ID.String())
-		ij, err := json.Marshal(identity.WithCredentialsInJSON(*di))
+		ij, err := json.Marshal(identity.WithCredentialsAndAdminMetadataInJSON(*di))
 		require.NoError(t, err)
 
-		ii := []string{"schema_url", "state_changed_at", "created_at", "updated_at", "credentials.oidc.created_at", "credentials.oidc.updated_at"}
+		ii := []string{"schema_url", "state_changed_at", "created_at", "updated_at", "credentials.oidc.created_at", "credentials.oidc.updated_at", "metadata_public"}
 		assertx.EqualAsJSONExcept(t, json.RawMessage(ij), json.RawMessage(stdOut), ii)
 	})
 }
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+
+	"github.com/pkg/errors"
 
 	kratos "github.com/ory/kratos-client-go"
 
@@ -15,6 +18,9 @@ import (
 	"github.com/ory/kratos/cmd/cliclient"
 )
 
+// importBatchSize is the maximum number of identities the server accepts in a single batch request.
+const importBatchSize = 1000
+
 // NewImportCmd represents the import command
 func NewImportCmd() *cobra.Command {
 	return &cobra.Command{
@@ -25,6 +31,13 @@ func NewImportCmd() *cobra.Command {
     "schema_id": "default",
     "traits": {
         "email": "foo@example.com"
+    },
+    "credentials": {
+        "password": {
+            "config": {
+                "hashed_password": "$2a$10$kb8epXUD0wrCkSkY7r1ceuty5UN9gQxpSQecKx23vLBxcfdKhlgHi"
+            }
+        }
     }
 }
 EOF
@@ -35,8 +48,10 @@ $ cat file.json | kratos identities import`,
 		Long: `Import identities from files or STD_IN.
 
 Files can contain only a single or an array of identities. The validity of files can be tested beforehand using "... identities validate".
+Identities are imported in batches of up to 1000 identities per request.
 
-WARNING: Importing credentials is not yet supported.`,
+Credentials can be imported alongside an identity using the "credentials" key. Passwords can be imported in
+cleartext or as bcrypt, argon2id, or pbkdf2 hashes, and OpenID Connect credentials as provider and subject pairs.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := cliclient.NewClient(cmd)
 
@@ -48,7 +63,15 @@ WARNING: Importing credentials is not yet supported.`,
 				return err
 			}
 
-			for src, i := range is {
+			sources := make([]string, 0, len(is))
+			patches := make([]kratos.BatchIdentityPatch, 0, len(is))
+			for src := range is {
+				sources = append(sources, src)
+			}
+			sort.Strings(sources)
+
+			for _, src := range sources {
+				i := is[src]
 				err = ValidateIdentity(cmd, src, i, func(ctx context.Context, id string) (map[string]interface{}, *http.Response, error) {
 					return c.V0alpha2Api.GetJsonSchema(ctx, id).Execute()
 				})
@@ -63,13 +86,36 @@ WARNING: Importing credentials is not yet supported.`,
 					return cmdx.FailSilently(cmd)
 				}
 
-				ident, _, err := c.V0alpha2Api.AdminCreateIdentity(cmd.Context()).AdminCreateIdentityBody(params).Execute()
+				patch := kratos.NewBatchIdentityPatch("create")
+				patch.SetCreate(params)
+				patches = append(patches, *patch)
+			}
+
+			for offset := 0; offset < len(patches); offset += importBatchSize {
+				end := offset + importBatchSize
+				if end > len(patches) {
+					end = len(patches)
+				}
+
+				res, _, err := c.V0alpha2Api.AdminBatchPatchIdentities(cmd.Context()).
+					AdminBatchPatchIdentitiesBody(*kratos.NewAdminBatchPatchIdentitiesBody(patches[offset:end])).
+					Execute()
 				if err != nil {
-					failed[src] = err
-				} else {
-					imported = append(imported, *ident)
+					for _, src := range sources[offset:end] {
+						failed[src] = err
+					}
+					continue
+				}
+
+				for k, result := range res.GetIdentities() {
+					if result.Error != nil {
+						failed[sources[offset+k]] = errors.New(result.Error.GetMessage())
+					} else {
+						imported = append(imported, result.GetIdentity())
+					}
 				}
 			}
+
 			if len(imported) == 1 {
 				cmdx.PrintRow(cmd, (*outputIdentity)(&imported[0]))
 			} else {
@@ -17,7 +17,10 @@ import (
 
 	kratos "github.com/ory/kratos-client-go"
 	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/hash"
+	"github.com/ory/kratos/identity"
 	"github.com/ory/x/cmdx"
+	"github.com/ory/x/pointerx"
 )
 
 func TestImportCmd(t *testing.T) {
@@ -122,6 +125,33 @@ func TestImportCmd(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("case=imports an identity with credentials", func(t *testing.T) {
+		i := kratos.AdminCreateIdentityBody{
+			SchemaId: config.DefaultIdentityTraitsSchemaID,
+			Traits:   map[string]interface{}{},
+			Credentials: &kratos.AdminIdentityImportCredentials{
+				Password: &kratos.AdminIdentityImportCredentialsPassword{
+					Config: &kratos.AdminIdentityImportCredentialsPasswordConfig{Password: pointerx.String("legacy-password")},
+				},
+			},
+		}
+		ij, err := json.Marshal(i)
+		require.NoError(t, err)
+
+		stdOut, stdErr, err := exec(c, bytes.NewBuffer(ij))
+		require.NoError(t, err, "%s %s", stdOut, stdErr)
+
+		id, err := uuid.FromString(gjson.Get(stdOut, "id").String())
+		require.NoError(t, err)
+		actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), id)
+		require.NoError(t, err)
+
+		var cc identity.CredentialsPassword
+		_, err = actual.ParseCredentials(identity.CredentialsTypePassword, &cc)
+		require.NoError(t, err)
+		assert.NoError(t, hash.Compare(context.Background(), []byte("legacy-password"), []byte(cc.HashedPassword)))
+	})
+
 	t.Run("case=fails to import invalid identity", func(t *testing.T) {
 		// validation is further tested with the validate command
 		stdOut, stdErr, err := exec(c, bytes.NewBufferString("{}"))
@@ -2,7 +2,10 @@ package identities
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/ory/x/cmdx"
 
@@ -11,11 +14,37 @@ import (
 	"github.com/ory/kratos/cmd/cliclient"
 )
 
+const (
+	FlagCredentialsIdentifier       = "credentials-identifier"
+	FlagCredentialsIdentifierPrefix = "credentials-identifier-prefix"
+	FlagState                       = "state"
+	FlagSchemaID                    = "schema-id"
+	FlagCreatedAfter                = "created-after"
+	FlagCreatedBefore               = "created-before"
+	FlagTrait                       = "trait"
+	FlagPageToken                   = "page-token"
+)
+
+var nextPageTokenPattern = regexp.MustCompile(`[?&]page_token=([^&>]+)[^>]*>; rel="next"`)
+
 func NewListCmd() *cobra.Command {
-	return &cobra.Command{
+	var (
+		credentialsIdentifier, credentialsIdentifierPrefix string
+		state, schemaID                                    string
+		createdAfter, createdBefore                        string
+		traits                                             []string
+		pageToken                                          string
+	)
+
+	cmd := &cobra.Command{
 		Use:   "list [<page> <per-page>]",
 		Short: "List identities",
-		Long:  "List identities (paginated)",
+		Long: `List identities (paginated). Use the filter flags to narrow down the result; all filters are combined using AND.
+
+Identities are paginated using page tokens. If there are more identities, the token of the next page is printed to stderr and can be passed using --page-token. The <page> argument uses the deprecated offset pagination.`,
+		Example: `To list all active identities with an email trait at the domain "ory.sh", run:
+
+	$ kratos identities list --state active --trait email=foo@ory.sh`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			// zero or exactly two args
 			if len(args) != 0 && len(args) != 2 {
@@ -44,12 +73,61 @@ func NewListCmd() *cobra.Command {
 				req = req.PerPage(perPage)
 			}
 
-			identities, _, err := req.Execute()
+			if pageToken != "" {
+				req = req.PageToken(pageToken)
+			}
+
+			if credentialsIdentifier != "" {
+				req = req.CredentialsIdentifier(credentialsIdentifier)
+			}
+			if credentialsIdentifierPrefix != "" {
+				req = req.CredentialsIdentifierPrefix(credentialsIdentifierPrefix)
+			}
+			if state != "" {
+				req = req.State(state)
+			}
+			if schemaID != "" {
+				req = req.SchemaId(schemaID)
+			}
+			if createdAfter != "" {
+				t, err := time.Parse(time.RFC3339, createdAfter)
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not parse --%s \"%s\" as RFC3339 timestamp: %s\n", FlagCreatedAfter, createdAfter, err)
+					return cmdx.FailSilently(cmd)
+				}
+				req = req.CreatedAfter(t)
+			}
+			if createdBefore != "" {
+				t, err := time.Parse(time.RFC3339, createdBefore)
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not parse --%s \"%s\" as RFC3339 timestamp: %s\n", FlagCreatedBefore, createdBefore, err)
+					return cmdx.FailSilently(cmd)
+				}
+				req = req.CreatedBefore(t)
+			}
+			if len(traits) > 0 {
+				filter := make(map[string]string, len(traits))
+				for _, trait := range traits {
+					parts := strings.SplitN(trait, "=", 2)
+					if len(parts) != 2 || parts[0] == "" {
+						_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not parse --%s \"%s\", expected <path>=<value>\n", FlagTrait, trait)
+						return cmdx.FailSilently(cmd)
+					}
+					filter["traits."+parts[0]] = parts[1]
+				}
+				req = req.Traits(filter)
+			}
+
+			identities, res, err := req.Execute()
 			if err != nil {
 				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not get the identities: %+v\n", err)
 				return cmdx.FailSilently(cmd)
 			}
 
+			if match := nextPageTokenPattern.FindStringSubmatch(res.Header.Get("Link")); len(match) == 2 {
+				_, _ = cmdx.NewLoudErrPrinter(cmd).Printf("Next page token: %s\n", match[1])
+			}
+
 			cmdx.PrintTable(cmd, &outputIdentityCollection{
 				identities: identities,
 			})
@@ -57,4 +135,15 @@ func NewListCmd() *cobra.Command {
 			return nil
 		},
 	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&credentialsIdentifier, FlagCredentialsIdentifier, "", "Only list identities with a credential matching this identifier exactly.")
+	flags.StringVar(&credentialsIdentifierPrefix, FlagCredentialsIdentifierPrefix, "", "Only list identities with a credential identifier starting with this prefix.")
+	flags.StringVar(&state, FlagState, "", `Only list identities in this state ("active" or "inactive").`)
+	flags.StringVar(&schemaID, FlagSchemaID, "", "Only list identities using this identity schema.")
+	flags.StringVar(&createdAfter, FlagCreatedAfter, "", "Only list identities created at or after this RFC3339 timestamp.")
+	flags.StringVar(&createdBefore, FlagCreatedBefore, "", "Only list identities created at or before this RFC3339 timestamp.")
+	flags.StringVar(&pageToken, FlagPageToken, "", "Get the page following the page which printed this token.")
+	flags.StringArrayVar(&traits, FlagTrait, []string{}, `Only list identities whose trait at <path> equals <value>, given as "<path>=<value>" (e.g. "name.first=Alice"). Can be repeated.`)
+	return cmd
 }
@@ -2,8 +2,10 @@ package identities_test
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ory/kratos/cmd/identities"
 
@@ -49,4 +51,47 @@ func TestListCmd(t *testing.T) {
 			assert.True(t, strings.Contains(stdoutP1, id) != strings.Contains(stdoutP2, id), "%s \n %s", stdoutP1, stdoutP2)
 		}
 	})
+
+	t.Run("case=lists identities matching the filter", func(t *testing.T) {
+		is, ids := makeIdentities(t, reg, 3)
+		defer deleteIdentities(t, is)
+
+		require.NoError(t, c.Flags().Set(identities.FlagState, "inactive"))
+		stdOut := execNoErr(t, c)
+		for _, id := range ids {
+			assert.NotContains(t, stdOut, id)
+		}
+
+		require.NoError(t, c.Flags().Set(identities.FlagState, "active"))
+		require.NoError(t, c.Flags().Set(identities.FlagCreatedAfter, is[0].CreatedAt.Add(-time.Minute).Format(time.RFC3339)))
+		stdOut = execNoErr(t, c)
+		for _, id := range ids {
+			assert.Contains(t, stdOut, id)
+		}
+
+		require.NoError(t, c.Flags().Set(identities.FlagState, ""))
+		require.NoError(t, c.Flags().Set(identities.FlagCreatedAfter, ""))
+	})
+
+	t.Run("case=lists identities after the page token", func(t *testing.T) {
+		is, ids := makeIdentities(t, reg, 3)
+		defer deleteIdentities(t, is)
+		sort.Sort(sort.Reverse(sort.StringSlice(ids)))
+
+		require.NoError(t, c.Flags().Set(identities.FlagPageToken, ids[0]))
+		stdOut := execNoErr(t, c)
+		assert.NotContains(t, stdOut, ids[0])
+		assert.Contains(t, stdOut, ids[1])
+		assert.Contains(t, stdOut, ids[2])
+		require.NoError(t, c.Flags().Set(identities.FlagPageToken, ""))
+	})
+
+	t.Run("case=fails with an invalid filter", func(t *testing.T) {
+		require.NoError(t, c.Flags().Set(identities.FlagCreatedBefore, "yesterday"))
+		assert.Contains(t, execErr(t, c), "Could not parse --created-before")
+		require.NoError(t, c.Flags().Set(identities.FlagCreatedBefore, ""))
+
+		require.NoError(t, c.Flags().Set(identities.FlagTrait, "email"))
+		assert.Contains(t, execErr(t, c), "Could not parse --trait")
+	})
 }
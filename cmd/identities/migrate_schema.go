@@ -0,0 +1,86 @@
+package identities
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	kratos "github.com/ory/kratos-client-go"
+	"github.com/ory/kratos/cmd/cliclient"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/cmdx"
+)
+
+const (
+	FlagFrom      = "from"
+	FlagTo        = "to"
+	FlagTransform = "transform"
+	FlagDryRun    = "dry-run"
+)
+
+func NewMigrateSchemaCmd() *cobra.Command {
+	var (
+		from, to, transform string
+		dryRun              bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-schema",
+		Short: "Migrate identities to another identity schema",
+		Long: `This command moves all identities using the identity schema given by --from to the identity schema given by --to.
+
+The traits of each identity can be transformed using a Jsonnet file. The identity is available as std.extVar('identity') and the file must return an object with key identity.traits. The transformed traits are validated against the target schema. Identities which can not be migrated are printed and left untouched.
+
+Use --dry-run to check which identities would fail the migration without updating any identity.`,
+		Example: `To rename the trait "department" to "team" while moving identities from schema "employee-v1" to "employee-v2", run:
+
+	$ cat mapper.jsonnet
+	local traits = std.extVar('identity').traits;
+	{ identity: { traits: { email: traits.email, team: traits.department } } }
+
+	$ kratos identities migrate-schema --from employee-v1 --to employee-v2 --transform mapper.jsonnet --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := cliclient.NewClient(cmd)
+
+			body := kratos.NewAdminMigrateIdentitySchemaBody(to)
+			body.SetDryRun(dryRun)
+			if transform != "" {
+				code, err := ioutil.ReadFile(transform)
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s: Could not read transformation: %s\n", transform, err)
+					return cmdx.FailSilently(cmd)
+				}
+				body.SetTransform(string(code))
+			}
+
+			report, _, err := c.V0alpha2Api.AdminMigrateIdentitySchema(cmd.Context(), from).AdminMigrateIdentitySchemaBody(*body).Execute()
+			if err = x.SDKError(err); err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not migrate the identities: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			verb := "Migrated"
+			if report.DryRun {
+				verb = "Would migrate"
+			}
+			_, _ = cmdx.NewLoudErrPrinter(cmd).Printf("%s %d of %d identities from schema %q to %q.\n", verb, report.Migrated, report.Total, report.FromSchemaId, report.ToSchemaId)
+
+			cmdx.PrintTable(cmd, (*outputSchemaMigrationReport)(report))
+			if len(report.Failures) != 0 {
+				return cmdx.FailSilently(cmd)
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&from, FlagFrom, "", "The ID of the identity schema to migrate identities from.")
+	flags.StringVar(&to, FlagTo, "", "The ID of the identity schema to migrate identities to.")
+	flags.StringVar(&transform, FlagTransform, "", "Path to a Jsonnet file transforming the traits of each identity.")
+	flags.BoolVar(&dryRun, FlagDryRun, false, "Transform and validate the identities without updating them.")
+	_ = cmd.MarkFlagRequired(FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagTo)
+	return cmd
+}
@@ -0,0 +1,80 @@
+package identities_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/kratos/cmd/identities"
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/internal/testhelpers"
+	"github.com/ory/x/cmdx"
+)
+
+func TestMigrateSchemaCmd(t *testing.T) {
+	c := identities.NewMigrateSchemaCmd()
+	reg := setup(t, c)
+	testhelpers.SetIdentitySchemas(t, reg.Config(context.Background()), map[string]string{
+		"renamed": "file://./stubs/renamed.schema.json",
+	})
+
+	f, err := ioutil.TempFile("", "*.jsonnet")
+	require.NoError(t, err)
+	_, err = f.WriteString(`{identity: {traits: {renamedKey: std.extVar('identity').traits.testKey}}}`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	newIdentity := func(t *testing.T) *identity.Identity {
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = identity.Traits(`{"testKey":"foo"}`)
+		require.NoError(t, reg.Persister().CreateIdentity(context.Background(), i))
+		return i
+	}
+
+	t.Run("case=fails without transformation", func(t *testing.T) {
+		i := newIdentity(t)
+		t.Cleanup(func() {
+			require.NoError(t, reg.Persister().DeleteIdentity(context.Background(), i.ID))
+		})
+
+		stdOut, stdErr, err := exec(c, nil, "--from", config.DefaultIdentityTraitsSchemaID, "--to", "renamed", "--dry-run")
+		require.True(t, errors.Is(err, cmdx.ErrNoPrintButFail))
+		assert.Contains(t, stdErr, "Would migrate 0 of 1 identities", stdErr)
+		assert.Equal(t, i.ID.String(), gjson.Get(stdOut, "failures.0.identity_id").String(), stdOut)
+	})
+
+	t.Run("case=migrates identities using a transformation", func(t *testing.T) {
+		is := []*identity.Identity{newIdentity(t), newIdentity(t)}
+
+		stdOut, stdErr, err := exec(c, nil, "--from", config.DefaultIdentityTraitsSchemaID, "--to", "renamed", "--transform", f.Name(), "--dry-run")
+		require.NoError(t, err, stdErr)
+		assert.Contains(t, stdErr, "Would migrate 2 of 2 identities", stdErr)
+		assert.True(t, gjson.Get(stdOut, "dry_run").Bool(), stdOut)
+		assert.EqualValues(t, 2, gjson.Get(stdOut, "migrated").Int(), stdOut)
+
+		stdOut, stdErr, err = exec(c, nil, "--from", config.DefaultIdentityTraitsSchemaID, "--to", "renamed", "--transform", f.Name(), "--dry-run=false")
+		require.NoError(t, err, stdErr)
+		assert.Contains(t, stdErr, "Migrated 2 of 2 identities", stdErr)
+		assert.False(t, gjson.Get(stdOut, "dry_run").Bool(), stdOut)
+		assert.EqualValues(t, 2, gjson.Get(stdOut, "migrated").Int(), stdOut)
+
+		for _, i := range is {
+			actual, err := reg.Persister().GetIdentity(context.Background(), i.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "renamed", actual.SchemaID)
+			assert.Equal(t, "foo", gjson.GetBytes(actual.Traits, "renamedKey").String())
+		}
+	})
+
+	t.Run("case=fails with unknown schema", func(t *testing.T) {
+		_, stdErr, err := exec(c, nil, "--from", config.DefaultIdentityTraitsSchemaID, "--to", "does-not-exist")
+		require.True(t, errors.Is(err, cmdx.ErrNoPrintButFail))
+		assert.Contains(t, stdErr, "400 Bad Request", stdErr)
+	})
+}
@@ -1,21 +1,66 @@
 package identities
 
 import (
+	"encoding/json"
 	"fmt"
-	"os"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
+
+	kratos "github.com/ory/kratos-client-go"
+	"github.com/ory/kratos/cmd/cliclient"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/cmdx"
 )
 
 func NewPatchCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "patch <file.json [file-2.json [file-3.json] ...]>",
-		Short: "Patch identities by ID (not yet implemented)",
-		Args:  cobra.MinimumNArgs(1),
-		Run: func(cmd *cobra.Command, args []string) {
-			// TODO
-			fmt.Println("not yet implemented")
-			os.Exit(1)
+		Use:   "patch <id> [file.json]",
+		Short: "Patch an identity by ID using a JSON Patch",
+		Long: `This command applies a JSON Patch (RFC 6902) to the identity with the given ID. The patch is read from the given file or from STD_IN if no file is given.
+
+Operations may modify the identity's traits, state, and public and admin metadata. The patched identity is validated against its identity schema. If a "test" operation fails, the identity is not modified.`,
+		Example: `To change the email address of an identity only if it still has the old one, run:
+
+	$ echo '[{"op":"test","path":"/traits/email","value":"old@ory.sh"},{"op":"replace","path":"/traits/email","value":"new@ory.sh"}]' | kratos identities patch <id>
+
+To deactivate an identity, run:
+
+	$ echo '[{"op":"replace","path":"/state","value":"inactive"}]' | kratos identities patch <id>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := cliclient.NewClient(cmd)
+
+			var (
+				src = "STD_IN"
+				raw []byte
+				err error
+			)
+			if len(args) == 2 {
+				src = args[1]
+				raw, err = ioutil.ReadFile(src)
+			} else {
+				raw, err = ioutil.ReadAll(cmd.InOrStdin())
+			}
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s: Could not read patch: %s\n", src, err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			var patch []kratos.JsonPatch
+			if err := json.Unmarshal(raw, &patch); err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s: Could not parse patch, expected a JSON array of operations: %s\n", src, err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			identity, _, err := c.V0alpha2Api.AdminPatchIdentity(cmd.Context(), args[0]).JsonPatch(patch).Execute()
+			if err = x.SDKError(err); err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s: %s\n", args[0], err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			cmdx.PrintRow(cmd, (*outputIdentity)(identity))
+			return nil
 		},
 	}
 }
@@ -0,0 +1,84 @@
+package identities_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/kratos/cmd/identities"
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/cmdx"
+)
+
+func TestPatchCmd(t *testing.T) {
+	c := identities.NewPatchCmd()
+	reg := setup(t, c)
+
+	newIdentity := func(t *testing.T) *identity.Identity {
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = identity.Traits(`{"testKey":"foo"}`)
+		require.NoError(t, reg.Persister().CreateIdentity(context.Background(), i))
+		return i
+	}
+
+	t.Run("case=patches an identity from file", func(t *testing.T) {
+		i := newIdentity(t)
+		f, err := ioutil.TempFile("", "")
+		require.NoError(t, err)
+		_, err = f.WriteString(`[{"op":"replace","path":"/traits/testKey","value":"bar"},{"op":"replace","path":"/state","value":"inactive"}]`)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		stdOut := execNoErr(t, c, i.ID.String(), f.Name())
+		assert.Equal(t, "bar", gjson.Get(stdOut, "traits.testKey").String(), stdOut)
+		assert.Equal(t, "inactive", gjson.Get(stdOut, "state").String(), stdOut)
+
+		actual, err := reg.Persister().GetIdentity(context.Background(), i.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "bar", gjson.GetBytes(actual.Traits, "testKey").String())
+		assert.Equal(t, identity.StateInactive, actual.State)
+	})
+
+	t.Run("case=patches an identity from STD_IN", func(t *testing.T) {
+		i := newIdentity(t)
+
+		stdOut, stdErr, err := exec(c, bytes.NewBufferString(`[{"op":"remove","path":"/traits/testKey"}]`), i.ID.String())
+		require.NoError(t, err, stdErr)
+		assert.False(t, gjson.Get(stdOut, "traits.testKey").Exists(), stdOut)
+	})
+
+	t.Run("case=fails if a test operation fails", func(t *testing.T) {
+		i := newIdentity(t)
+
+		stdOut, stdErr, err := exec(c, bytes.NewBufferString(`[{"op":"test","path":"/traits/testKey","value":"baz"},{"op":"replace","path":"/traits/testKey","value":"bar"}]`), i.ID.String())
+		require.True(t, errors.Is(err, cmdx.ErrNoPrintButFail))
+		assert.Len(t, stdOut, 0)
+		assert.Contains(t, stdErr, "409 Conflict", stdErr)
+
+		actual, err := reg.Persister().GetIdentity(context.Background(), i.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", gjson.GetBytes(actual.Traits, "testKey").String())
+	})
+
+	t.Run("case=fails with invalid patch", func(t *testing.T) {
+		i := newIdentity(t)
+
+		_, stdErr, err := exec(c, bytes.NewBufferString(`{"op":"replace"}`), i.ID.String())
+		require.True(t, errors.Is(err, cmdx.ErrNoPrintButFail))
+		assert.Contains(t, stdErr, "Could not parse patch", stdErr)
+	})
+
+	t.Run("case=fails with unknown ID", func(t *testing.T) {
+		_, stdErr, err := exec(c, bytes.NewBufferString(`[{"op":"replace","path":"/state","value":"inactive"}]`), x.NewUUID().String())
+		require.True(t, errors.Is(err, cmdx.ErrNoPrintButFail))
+		assert.Contains(t, stdErr, "404 Not Found", stdErr)
+	})
+}
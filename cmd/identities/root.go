@@ -30,4 +30,5 @@ func RegisterCommandRecursive(parent *cobra.Command) {
 	c.AddCommand(NewGetCmd())
 	c.AddCommand(NewDeleteCmd())
 	c.AddCommand(NewPatchCmd())
+	c.AddCommand(NewMigrateSchemaCmd())
 }
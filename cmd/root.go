@@ -7,6 +7,7 @@ import (
 
 	"github.com/ory/kratos/driver/config"
 
+	"github.com/ory/kratos/cmd/cleanup"
 	"github.com/ory/kratos/cmd/courier"
 	"github.com/ory/kratos/cmd/hashers"
 
@@ -31,6 +32,7 @@ func NewRootCmd() (cmd *cobra.Command) {
 	jsonnet.RegisterCommandRecursive(cmd)
 	serve.RegisterCommandRecursive(cmd)
 	migrate.RegisterCommandRecursive(cmd)
+	cleanup.RegisterCommandRecursive(cmd)
 	remote.RegisterCommandRecursive(cmd)
 	hashers.RegisterCommandRecursive(cmd)
 	courier.RegisterCommandRecursive(cmd)
@@ -44,8 +44,12 @@ type (
 	Provider interface {
 		Courier(ctx context.Context) *Courier
 	}
+	Config interface {
+		SMTPConfig
+		SMSConfig
+	}
 	ConfigProvider interface {
-		CourierConfig(ctx context.Context) SMTPConfig
+		CourierConfig(ctx context.Context) Config
 	}
 )
 
@@ -232,6 +236,25 @@ func (m *Courier) DispatchMessage(ctx context.Context, msg Message) error {
 			WithField("message_subject", msg.Subject).
 			Debug("Courier sent out message.")
 		return nil
+	case MessageTypeSMS:
+		if err := m.dispatchSMS(ctx, msg); err != nil {
+			return err
+		}
+
+		if err := m.d.CourierPersister().SetMessageStatus(ctx, msg.ID, MessageStatusSent); err != nil {
+			m.d.Logger().
+				WithError(err).
+				WithField("message_id", msg.ID).
+				Error(`Unable to set the message status to "sent".`)
+			return err
+		}
+
+		m.d.Logger().
+			WithField("message_id", msg.ID).
+			WithField("message_type", msg.Type).
+			WithField("message_template_type", msg.TemplateType).
+			Debug("Courier sent out message.")
+		return nil
 	}
 	return errors.Errorf("received unexpected message type: %d", msg.Type)
 }
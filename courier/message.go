@@ -21,6 +21,7 @@ type MessageType int
 
 const (
 	MessageTypeEmail MessageType = iota + 1
+	MessageTypeSMS
 )
 
 // swagger:ignore
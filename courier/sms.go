@@ -0,0 +1,166 @@
+package courier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/google/go-jsonnet"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/fetcher"
+)
+
+type (
+	SMSConfig interface {
+		CourierSMSEnabled() bool
+		CourierSMSFrom() string
+		CourierSMSRequestConfig() json.RawMessage
+	}
+
+	smsRequestConfig struct {
+		URL     string            `json:"url"`
+		Method  string            `json:"method"`
+		Body    string            `json:"body"`
+		Headers map[string]string `json:"headers"`
+	}
+
+	// smsRequestContext is passed as `ctx` to the Jsonnet template which renders the request body.
+	smsRequestContext struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+		Body string `json:"body"`
+	}
+)
+
+var smsClient = &http.Client{Timeout: time.Second * 10}
+
+func (m *Courier) QueueSMS(ctx context.Context, t SMSTemplate) (uuid.UUID, error) {
+	if !m.d.CourierConfig(ctx).CourierSMSEnabled() {
+		return uuid.Nil, errors.WithStack(herodot.ErrInternalServerError.WithReasonf("Courier tried to queue an SMS but courier.sms.enabled is false!"))
+	}
+
+	recipient, err := t.PhoneNumber()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	body, err := t.SMSBody()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	templateType, err := GetSMSTemplateType(t)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	templateData, err := json.Marshal(t)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	message := &Message{
+		Status:       MessageStatusQueued,
+		Type:         MessageTypeSMS,
+		Recipient:    recipient,
+		Body:         body,
+		TemplateType: templateType,
+		TemplateData: templateData,
+	}
+
+	if err := m.d.CourierPersister().AddMessage(ctx, message); err != nil {
+		return uuid.Nil, err
+	}
+	return message.ID, nil
+}
+
+func (m *Courier) dispatchSMS(ctx context.Context, msg Message) error {
+	var c smsRequestConfig
+	if err := json.Unmarshal(m.d.CourierConfig(ctx).CourierSMSRequestConfig(), &c); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(c.URL) == 0 {
+		return errors.WithStack(herodot.ErrInternalServerError.WithReasonf("Courier tried to deliver an SMS but courier.sms.request_config.url is not set!"))
+	}
+
+	if len(c.Method) == 0 {
+		c.Method = "POST"
+	}
+
+	body, err := newSMSRequestBody(c.Body, &smsRequestContext{
+		From: m.d.CourierConfig(ctx).CourierSMSFrom(),
+		To:   msg.Recipient,
+		Body: msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, c.Method, c.URL, body)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := smsClient.Do(req)
+	if err != nil {
+		m.d.Logger().
+			WithError(err).
+			WithField("message_id", msg.ID).
+			Error("Unable to send SMS using the configured HTTP API.")
+		return errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		// Drain the body so that the connection can be reused.
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		m.d.Logger().
+			WithField("message_id", msg.ID).
+			WithField("status_code", res.StatusCode).
+			Error("The HTTP API of the SMS provider did not accept the SMS.")
+		return errors.Errorf("sms provider responded with unexpected status code: %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// newSMSRequestBody renders the request body using the Jsonnet template at templateURI. If no template is
+// configured, the JSON encoding of the context is sent instead.
+func newSMSRequestBody(templateURI string, data *smsRequestContext) (io.Reader, error) {
+	var b bytes.Buffer
+	if err := json.NewEncoder(&b).Encode(data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(templateURI) == 0 {
+		return &b, nil
+	}
+
+	template, err := fetcher.NewFetcher().Fetch(templateURI)
+	if err != nil {
+		return nil, err
+	}
+
+	vm := jsonnet.MakeVM()
+	vm.TLACode("ctx", b.String())
+
+	res, err := vm.EvaluateAnonymousSnippet(templateURI, template.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to render the SMS request body")
+	}
+
+	return bytes.NewBufferString(res), nil
+}
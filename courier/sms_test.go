@@ -0,0 +1,75 @@
+package courier_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	templates "github.com/ory/kratos/courier/template"
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/internal"
+)
+
+func TestQueueSMS(t *testing.T) {
+	ctx := context.Background()
+
+	var bodies [][]byte
+	var headers []http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, body)
+		headers = append(headers, r.Header)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	t.Cleanup(ts.Close)
+
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	c := reg.Courier(ctx)
+	model := &templates.VerificationValidSMSModel{To: "+4917612345678", Code: "123456", VerificationURL: "https://www.ory.sh/"}
+
+	t.Run("case=fails if sms is disabled", func(t *testing.T) {
+		_, err := c.QueueSMS(ctx, templates.NewVerificationValidSMS(conf, model))
+		require.Error(t, err)
+	})
+
+	conf.MustSet(config.ViperKeyCourierSMSEnabled, true)
+	conf.MustSet(config.ViperKeyCourierSMSFrom, "Ory")
+	conf.MustSet(config.ViperKeyCourierSMSRequestConfig, map[string]interface{}{
+		"url":     ts.URL,
+		"headers": map[string]interface{}{"Authorization": "Bearer secret"},
+	})
+
+	t.Run("case=sends the message as json", func(t *testing.T) {
+		bodies, headers = nil, nil
+		_, err := c.QueueSMS(ctx, templates.NewVerificationValidSMS(conf, model))
+		require.NoError(t, err)
+		require.NoError(t, c.DispatchQueue(ctx))
+
+		require.Len(t, bodies, 1)
+		assert.Equal(t, "Ory", gjson.GetBytes(bodies[0], "from").String())
+		assert.Equal(t, "+4917612345678", gjson.GetBytes(bodies[0], "to").String())
+		assert.Contains(t, gjson.GetBytes(bodies[0], "body").String(), "123456")
+		assert.Equal(t, "Bearer secret", headers[0].Get("Authorization"))
+	})
+
+	t.Run("case=renders the body template", func(t *testing.T) {
+		bodies, headers = nil, nil
+		conf.MustSet(config.ViperKeyCourierSMSRequestConfig+".body", "base64://"+base64.StdEncoding.EncodeToString([]byte(`function(ctx) { To: ctx.to, Body: ctx.body }`)))
+		_, err := c.QueueSMS(ctx, templates.NewRecoveryValidSMS(conf, &templates.RecoveryValidSMSModel{To: "+4917612345678", Code: "654321"}))
+		require.NoError(t, err)
+		require.NoError(t, c.DispatchQueue(ctx))
+
+		require.Len(t, bodies, 1)
+		assert.Equal(t, "+4917612345678", gjson.GetBytes(bodies[0], "To").String())
+		assert.Contains(t, gjson.GetBytes(bodies[0], "Body").String(), "654321")
+		assert.False(t, gjson.GetBytes(bodies[0], "from").Exists())
+	})
+}
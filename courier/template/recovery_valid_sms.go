@@ -0,0 +1,34 @@
+package template
+
+import (
+	"encoding/json"
+)
+
+type (
+	RecoveryValidSMS struct {
+		c TemplateConfig
+		m *RecoveryValidSMSModel
+	}
+	RecoveryValidSMSModel struct {
+		To          string
+		Code        string
+		RecoveryURL string
+		Identity    map[string]interface{}
+	}
+)
+
+func NewRecoveryValidSMS(c TemplateConfig, m *RecoveryValidSMSModel) *RecoveryValidSMS {
+	return &RecoveryValidSMS{c: c, m: m}
+}
+
+func (t *RecoveryValidSMS) PhoneNumber() (string, error) {
+	return t.m.To, nil
+}
+
+func (t *RecoveryValidSMS) SMSBody() (string, error) {
+	return loadTextTemplate(t.c.CourierTemplatesRoot(), "recovery/valid/sms.body.gotmpl", "recovery/valid/sms.body*", t.m)
+}
+
+func (t *RecoveryValidSMS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.m)
+}
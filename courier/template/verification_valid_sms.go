@@ -0,0 +1,34 @@
+package template
+
+import (
+	"encoding/json"
+)
+
+type (
+	VerificationValidSMS struct {
+		c TemplateConfig
+		m *VerificationValidSMSModel
+	}
+	VerificationValidSMSModel struct {
+		To              string
+		Code            string
+		VerificationURL string
+		Identity        map[string]interface{}
+	}
+)
+
+func NewVerificationValidSMS(c TemplateConfig, m *VerificationValidSMSModel) *VerificationValidSMS {
+	return &VerificationValidSMS{c: c, m: m}
+}
+
+func (t *VerificationValidSMS) PhoneNumber() (string, error) {
+	return t.m.To, nil
+}
+
+func (t *VerificationValidSMS) SMSBody() (string, error) {
+	return loadTextTemplate(t.c.CourierTemplatesRoot(), "verification/valid/sms.body.gotmpl", "verification/valid/sms.body*", t.m)
+}
+
+func (t *VerificationValidSMS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.m)
+}
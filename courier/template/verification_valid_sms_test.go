@@ -0,0 +1,24 @@
+package template_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/kratos/courier/template"
+	"github.com/ory/kratos/internal"
+)
+
+func TestVerifyValidSMS(t *testing.T) {
+	conf, _ := internal.NewFastRegistryWithMocks(t)
+	tpl := template.NewVerificationValidSMS(conf, &template.VerificationValidSMSModel{To: "+4917612345678", Code: "123456"})
+
+	rendered, err := tpl.SMSBody()
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "123456")
+
+	to, err := tpl.PhoneNumber()
+	require.NoError(t, err)
+	assert.Equal(t, "+4917612345678", to)
+}
@@ -17,14 +17,21 @@ type (
 		EmailBodyPlaintext() (string, error)
 		EmailRecipient() (string, error)
 	}
+	SMSTemplate interface {
+		json.Marshaler
+		SMSBody() (string, error)
+		PhoneNumber() (string, error)
+	}
 )
 
 const (
-	TypeRecoveryInvalid     TemplateType = "recovery_invalid"
-	TypeRecoveryValid       TemplateType = "recovery_valid"
-	TypeVerificationInvalid TemplateType = "verification_invalid"
-	TypeVerificationValid   TemplateType = "verification_valid"
-	TypeTestStub            TemplateType = "stub"
+	TypeRecoveryInvalid      TemplateType = "recovery_invalid"
+	TypeRecoveryValid        TemplateType = "recovery_valid"
+	TypeVerificationInvalid  TemplateType = "verification_invalid"
+	TypeVerificationValid    TemplateType = "verification_valid"
+	TypeRecoveryValidSMS     TemplateType = "recovery_valid_sms"
+	TypeVerificationValidSMS TemplateType = "verification_valid_sms"
+	TypeTestStub             TemplateType = "stub"
 )
 
 func GetTemplateType(t EmailTemplate) (TemplateType, error) {
@@ -44,6 +51,17 @@ func GetTemplateType(t EmailTemplate) (TemplateType, error) {
 	}
 }
 
+func GetSMSTemplateType(t SMSTemplate) (TemplateType, error) {
+	switch t.(type) {
+	case *template.RecoveryValidSMS:
+		return TypeRecoveryValidSMS, nil
+	case *template.VerificationValidSMS:
+		return TypeVerificationValidSMS, nil
+	default:
+		return "", errors.Errorf("unexpected template type")
+	}
+}
+
 func NewEmailTemplateFromMessage(c SMTPConfig, msg Message) (EmailTemplate, error) {
 	switch msg.TemplateType {
 	case TypeRecoveryInvalid:
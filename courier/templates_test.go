@@ -30,6 +30,19 @@ func TestGetTemplateType(t *testing.T) {
 	}
 }
 
+func TestGetSMSTemplateType(t *testing.T) {
+	for expectedType, tmpl := range map[courier.TemplateType]courier.SMSTemplate{
+		courier.TypeRecoveryValidSMS:     &template.RecoveryValidSMS{},
+		courier.TypeVerificationValidSMS: &template.VerificationValidSMS{},
+	} {
+		t.Run(fmt.Sprintf("case=%s", expectedType), func(t *testing.T) {
+			actualType, err := courier.GetSMSTemplateType(tmpl)
+			require.NoError(t, err)
+			require.Equal(t, expectedType, actualType)
+		})
+	}
+}
+
 func TestNewEmailTemplateFromMessage(t *testing.T) {
 	conf := internal.NewConfigurationWithDefaults(t)
 	for tmplType, expectedTmpl := range map[courier.TemplateType]courier.EmailTemplate{
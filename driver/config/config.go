@@ -63,6 +63,9 @@ const (
 	ViperKeyCourierSMTPFrom                                  = "courier.smtp.from_address"
 	ViperKeyCourierSMTPFromName                              = "courier.smtp.from_name"
 	ViperKeyCourierSMTPHeaders                               = "courier.smtp.headers"
+	ViperKeyCourierSMSEnabled                                = "courier.sms.enabled"
+	ViperKeyCourierSMSFrom                                   = "courier.sms.from"
+	ViperKeyCourierSMSRequestConfig                          = "courier.sms.request_config"
 	ViperKeySecretsDefault                                   = "secrets.default"
 	ViperKeySecretsCookie                                    = "secrets.cookie"
 	ViperKeySecretsCipher                                    = "secrets.cipher"
@@ -78,6 +81,7 @@ const (
 	ViperKeyPublicTLSKeyBase64                               = "serve.public.tls.key.base64"
 	ViperKeyPublicTLSCertPath                                = "serve.public.tls.cert.path"
 	ViperKeyPublicTLSKeyPath                                 = "serve.public.tls.key.path"
+	ViperKeyPublicTrustedProxies                             = "serve.public.trusted_proxies"
 	ViperKeyDisableAdminHealthRequestLog                     = "serve.admin.request_log.disable_for_health"
 	ViperKeyAdminBaseURL                                     = "serve.admin.base_url"
 	ViperKeyAdminPort                                        = "serve.admin.port"
@@ -90,6 +94,8 @@ const (
 	ViperKeyAdminTLSCertPath                                 = "serve.admin.tls.cert.path"
 	ViperKeyAdminTLSKeyPath                                  = "serve.admin.tls.key.path"
 	ViperKeySessionLifespan                                  = "session.lifespan"
+	ViperKeySessionIdleTimeout                               = "session.idle_timeout"
+	ViperKeySessionEarliestPossibleExtend                    = "session.earliest_possible_extend"
 	ViperKeySessionSameSite                                  = "session.cookie.same_site"
 	ViperKeySessionDomain                                    = "session.cookie.domain"
 	ViperKeySessionName                                      = "session.cookie.name"
@@ -129,6 +135,10 @@ const (
 	ViperKeySelfServiceVerificationAfter                     = "selfservice.flows.verification.after"
 	ViperKeyDefaultIdentitySchemaURL                         = "identity.default_schema_url"
 	ViperKeyIdentitySchemas                                  = "identity.schemas"
+	ViperKeyIdentitySoftDeleteEnabled                        = "identity.soft_delete.enabled"
+	ViperKeyIdentitySoftDeleteRetention                      = "identity.soft_delete.retention"
+	ViperKeyIdentityHistoryEnabled                           = "identity.history.enabled"
+	ViperKeyIdentityHistoryRetention                         = "identity.history.retention"
 	ViperKeyHasherAlgorithm                                  = "hashers.algorithm"
 	ViperKeyHasherArgon2ConfigMemory                         = "hashers.argon2.memory"
 	ViperKeyHasherArgon2ConfigIterations                     = "hashers.argon2.iterations"
@@ -270,7 +280,7 @@ func New(ctx context.Context, l *logrusx.Logger, stdOutOrErr io.Writer, opts ...
 
 	opts = append([]configx.OptionModifier{
 		configx.WithStderrValidationReporter(),
-		configx.OmitKeysFromTracing("dsn", "courier.smtp.connection_uri", "secrets.default", "secrets.cookie", "secrets.cipher", "client_secret"),
+		configx.OmitKeysFromTracing("dsn", "courier.smtp.connection_uri", "courier.sms.request_config", "secrets.default", "secrets.cookie", "secrets.cipher", "client_secret"),
 		configx.WithImmutables("serve", "profiling", "log"),
 		configx.WithLogrusWatcher(l),
 		configx.WithLogger(l),
@@ -467,6 +477,22 @@ func (p *Config) IdentityTraitsSchemas() Schemas {
 	return append(ss, ds)
 }
 
+func (p *Config) IdentitySoftDeleteEnabled() bool {
+	return p.p.Bool(ViperKeyIdentitySoftDeleteEnabled)
+}
+
+func (p *Config) IdentitySoftDeleteRetention() time.Duration {
+	return p.p.DurationF(ViperKeyIdentitySoftDeleteRetention, time.Hour*24*30)
+}
+
+func (p *Config) IdentityHistoryEnabled() bool {
+	return p.p.Bool(ViperKeyIdentityHistoryEnabled)
+}
+
+func (p *Config) IdentityHistoryRetention() time.Duration {
+	return p.p.DurationF(ViperKeyIdentityHistoryRetention, time.Hour*24*90)
+}
+
 func (p *Config) AdminListenOn() string {
 	return p.listenOn("admin")
 }
@@ -483,6 +509,27 @@ func (p *Config) PublicSocketPermission() *configx.UnixPermission {
 	}
 }
 
+func (p *Config) PublicTrustedProxies() (ns []*net.IPNet) {
+	for k, v := range p.p.Strings(ViperKeyPublicTrustedProxies) {
+		cidr := v
+		if !strings.Contains(cidr, "/") {
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			p.l.WithError(err).Warnf("Ignoring trusted proxy \"%s\" from configuration key \"%s.%d\".", v, ViperKeyPublicTrustedProxies, k)
+			continue
+		}
+		ns = append(ns, n)
+	}
+	return ns
+}
+
 func (p *Config) AdminSocketPermission() *configx.UnixPermission {
 	return &configx.UnixPermission{
 		Owner: p.p.String(ViperKeyAdminSocketOwner),
@@ -794,6 +841,17 @@ func (p *Config) SessionLifespan() time.Duration {
 	return p.p.DurationF(ViperKeySessionLifespan, time.Hour*24)
 }
 
+// SessionIdleTimeout returns 0 when the value is not set which disables the sliding expiration of sessions.
+func (p *Config) SessionIdleTimeout() time.Duration {
+	return p.p.DurationF(ViperKeySessionIdleTimeout, 0)
+}
+
+// SessionEarliestPossibleExtend returns the idle timeout when the value is not set, allowing sessions to be
+// extended on every request.
+func (p *Config) SessionEarliestPossibleExtend() time.Duration {
+	return p.p.DurationF(ViperKeySessionEarliestPossibleExtend, p.SessionIdleTimeout())
+}
+
 func (p *Config) SessionPersistentCookie() bool {
 	return p.p.Bool(ViperKeySessionPersistentCookie)
 }
@@ -861,6 +919,25 @@ func (p *Config) CourierSMTPHeaders() map[string]string {
 	return p.p.StringMap(ViperKeyCourierSMTPHeaders)
 }
 
+func (p *Config) CourierSMSEnabled() bool {
+	return p.p.Bool(ViperKeyCourierSMSEnabled)
+}
+
+func (p *Config) CourierSMSFrom() string {
+	return p.p.String(ViperKeyCourierSMSFrom)
+}
+
+func (p *Config) CourierSMSRequestConfig() json.RawMessage {
+	config := "{}"
+	out, err := p.p.Marshal(kjson.Parser())
+	if err != nil {
+		p.l.WithError(err).Warn("Unable to marshal SMS request configuration.")
+	} else if c := gjson.GetBytes(out, ViperKeyCourierSMSRequestConfig).Raw; len(c) > 0 {
+		config = c
+	}
+	return json.RawMessage(config)
+}
+
 func splitUrlAndFragment(s string) (string, string) {
 	i := strings.IndexByte(s, '#')
 	if i < 0 {
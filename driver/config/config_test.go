@@ -602,11 +602,33 @@ func TestSession(t *testing.T) {
 	p.MustSet(config.ViperKeySessionLifespan, "1m")
 	assert.Equal(t, time.Minute, p.SessionLifespan())
 
+	assert.Equal(t, time.Duration(0), p.SessionIdleTimeout())
+	assert.Equal(t, time.Duration(0), p.SessionEarliestPossibleExtend())
+	p.MustSet(config.ViperKeySessionIdleTimeout, "30s")
+	assert.Equal(t, 30*time.Second, p.SessionIdleTimeout())
+	assert.Equal(t, 30*time.Second, p.SessionEarliestPossibleExtend())
+	p.MustSet(config.ViperKeySessionEarliestPossibleExtend, "10s")
+	assert.Equal(t, 10*time.Second, p.SessionEarliestPossibleExtend())
+
 	assert.Equal(t, true, p.SessionPersistentCookie())
 	p.MustSet(config.ViperKeySessionPersistentCookie, false)
 	assert.Equal(t, false, p.SessionPersistentCookie())
 }
 
+func TestPublicTrustedProxies(t *testing.T) {
+	l := logrusx.New("", "")
+	p := config.MustNew(t, l, os.Stderr, configx.SkipValidation())
+
+	assert.Empty(t, p.PublicTrustedProxies())
+
+	p.MustSet(config.ViperKeyPublicTrustedProxies, []string{"127.0.0.1", "10.0.0.0/8", "::1", "not-an-ip", "10.0.0.0/99"})
+	var actual []string
+	for _, n := range p.PublicTrustedProxies() {
+		actual = append(actual, n.String())
+	}
+	assert.Equal(t, []string{"127.0.0.1/32", "10.0.0.0/8", "::1/128"}, actual)
+}
+
 func TestCookies(t *testing.T) {
 	l := logrusx.New("", "")
 	p := config.MustNew(t, l, os.Stderr, configx.SkipValidation())
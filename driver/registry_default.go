@@ -259,7 +259,7 @@ func (m *RegistryDefault) Config(ctx context.Context) *config.Config {
 	return corp.ContextualizeConfig(ctx, m.c)
 }
 
-func (m *RegistryDefault) CourierConfig(ctx context.Context) courier.SMTPConfig {
+func (m *RegistryDefault) CourierConfig(ctx context.Context) courier.Config {
 	return m.Config(ctx)
 }
 
@@ -1,3 +1,50 @@
 package identity
 
-const AddressTypeEmail = "email"
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AddressTypeEmail = "email"
+	AddressTypePhone = "sms"
+)
+
+// ErrInvalidPhoneNumber is returned by NormalizePhoneNumber if the value is not a phone number in E.164 format.
+var ErrInvalidPhoneNumber = errors.New("the phone number must be in E.164 format, e.g. +4917612345678")
+
+// NormalizePhoneNumber returns the E.164 representation of a phone number such as `+49 (176) 123-45678`.
+//
+// Spaces, dashes, dots, and parentheses are removed and an international call prefix of `00` is replaced by `+`.
+// The number must contain the country code as local numbers can not be normalized without knowing the country.
+func NormalizePhoneNumber(value string) (string, error) {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '\t':
+			return -1
+		}
+		return r
+	}, value)
+
+	if strings.HasPrefix(normalized, "00") {
+		normalized = "+" + normalized[2:]
+	}
+
+	if !strings.HasPrefix(normalized, "+") {
+		return "", errors.WithStack(ErrInvalidPhoneNumber)
+	}
+
+	// E.164 numbers have at most 15 digits and country codes never start with 0.
+	digits := normalized[1:]
+	if len(digits) < 7 || len(digits) > 15 || digits[0] == '0' {
+		return "", errors.WithStack(ErrInvalidPhoneNumber)
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", errors.WithStack(ErrInvalidPhoneNumber)
+		}
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,38 @@
+package identity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	for k, tc := range []struct {
+		in     string
+		expect string
+	}{
+		{in: "+4917612345678", expect: "+4917612345678"},
+		{in: "+49 176 12345678", expect: "+4917612345678"},
+		{in: "+49 (176) 123-45678", expect: "+4917612345678"},
+		{in: "0049.176.12345678", expect: "+4917612345678"},
+		{in: "+1 415-555-2671", expect: "+14155552671"},
+		{in: "017612345678"},
+		{in: "+017612345678"},
+		{in: "+49 176 1234567a"},
+		{in: "+49"},
+		{in: "+4917612345678901234"},
+		{in: ""},
+	} {
+		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
+			actual, err := NormalizePhoneNumber(tc.in)
+			if tc.expect == "" {
+				require.ErrorIs(t, err, ErrInvalidPhoneNumber)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, actual)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gobuffalo/pop/v5"
+	"github.com/gofrs/uuid"
+	"github.com/mohae/deepcopy"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+)
+
+// BatchPatchIdentitiesMaxItems is the maximum number of patches accepted by a single batch request.
+const BatchPatchIdentitiesMaxItems = 1000
+
+// batchChunkSize is the number of patches executed within a single transaction.
+var batchChunkSize = 100
+
+// BatchIdentityPatchAction is the action to perform on an identity in a batch.
+type BatchIdentityPatchAction string
+
+const (
+	BatchIdentityPatchActionCreate BatchIdentityPatchAction = "create"
+	BatchIdentityPatchActionUpdate BatchIdentityPatchAction = "update"
+	BatchIdentityPatchActionDelete BatchIdentityPatchAction = "delete"
+)
+
+// Payload for a single operation in a batch of identity patches.
+//
+// swagger:model batchIdentityPatch
+type BatchIdentityPatch struct {
+	// Action is the action to perform. One of `create`, `update`, or `delete`.
+	//
+	// required: true
+	Action BatchIdentityPatchAction `json:"action"`
+
+	// ID is the ID of the identity to update or delete. It must not be set when creating an identity.
+	ID uuid.UUID `json:"id"`
+
+	// Create is the payload for creating an identity. Required if the action is `create`.
+	Create *AdminCreateIdentityBody `json:"create,omitempty"`
+
+	// Update is the payload for updating an identity. Required if the action is `update`.
+	Update *AdminUpdateIdentityBody `json:"update,omitempty"`
+}
+
+// The result of a single operation in a batch of identity patches.
+//
+// swagger:model batchIdentityPatchResult
+type BatchIdentityPatchResult struct {
+	// Action is the action which was performed.
+	//
+	// required: true
+	Action BatchIdentityPatchAction `json:"action"`
+
+	// ID is the ID of the created, updated, or deleted identity. It is not set if creating the identity failed.
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	// Identity is the created or updated identity.
+	Identity *Identity `json:"identity,omitempty"`
+
+	// Error is set if the operation failed.
+	Error *herodot.DefaultError `json:"error,omitempty"`
+}
+
+// MarshalJSON includes the admin metadata of the identity as batches are only available in the admin API.
+func (r BatchIdentityPatchResult) MarshalJSON() ([]byte, error) {
+	type localResult BatchIdentityPatchResult
+	return json.Marshal(&struct {
+		localResult
+		Identity *WithAdminMetadataInJSON `json:"identity,omitempty"`
+	}{
+		localResult: localResult(r),
+		Identity:    (*WithAdminMetadataInJSON)(r.Identity),
+	})
+}
+
+type batchOperation struct {
+	patch    *BatchIdentityPatch
+	result   *BatchIdentityPatchResult
+	identity *Identity
+}
+
+// Batch validates and executes the given patches and returns one result per patch, in the same order.
+//
+// Patches which fail validation are skipped. The remaining patches are executed in transactions of up to
+// 100 patches. If such a transaction fails, its patches are retried one by one so that errors are reported
+// for the failing patches only.
+func (m *Manager) Batch(ctx context.Context, patches []*BatchIdentityPatch, opts ...ManagerOption) []*BatchIdentityPatchResult {
+	o := newManagerOptions(opts)
+
+	results := make([]*BatchIdentityPatchResult, len(patches))
+	operations := make([]*batchOperation, 0, len(patches))
+	for k, patch := range patches {
+		results[k] = &BatchIdentityPatchResult{Action: patch.Action}
+		op := &batchOperation{patch: patch, result: results[k]}
+
+		if err := m.prepareBatchOperation(ctx, op, o); err != nil {
+			op.result.Error = herodot.ToDefaultError(err, "")
+			continue
+		}
+
+		operations = append(operations, op)
+	}
+
+	for len(operations) > 0 {
+		chunk := operations
+		if len(chunk) > batchChunkSize {
+			chunk = chunk[:batchChunkSize]
+		}
+		operations = operations[len(chunk):]
+
+		if err := m.transaction(ctx, func(ctx context.Context) error {
+			for _, op := range chunk {
+				if err := m.executeBatchOperation(ctx, op); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err == nil {
+			for _, op := range chunk {
+				op.succeeded()
+			}
+			continue
+		}
+
+		for _, op := range chunk {
+			if err := m.transaction(ctx, func(ctx context.Context) error {
+				return m.executeBatchOperation(ctx, op)
+			}); err != nil {
+				op.result.Error = herodot.ToDefaultError(err, "")
+				continue
+			}
+			op.succeeded()
+		}
+	}
+
+	return results
+}
+
+func (m *Manager) prepareBatchOperation(ctx context.Context, op *batchOperation, o *managerOptions) error {
+	switch op.patch.Action {
+	case BatchIdentityPatchActionCreate:
+		if op.patch.Create == nil || op.patch.ID != uuid.Nil {
+			return errors.WithStack(herodot.ErrBadRequest.WithReason("Creating an identity requires field `create` and does not allow field `id`."))
+		}
+
+		i, err := op.patch.Create.toIdentity(ctx, m.r.Hasher())
+		if err != nil {
+			return err
+		}
+
+		if err := m.validate(ctx, i, o); err != nil {
+			return err
+		}
+
+		op.identity = i
+	case BatchIdentityPatchActionUpdate:
+		if op.patch.Update == nil || op.patch.ID == uuid.Nil {
+			return errors.WithStack(herodot.ErrBadRequest.WithReason("Updating an identity requires fields `id` and `update`."))
+		}
+
+		original, err := m.r.IdentityPool().(PrivilegedPool).GetIdentityConfidential(ctx, op.patch.ID)
+		if err != nil {
+			return err
+		}
+
+		updated := deepcopy.Copy(original).(*Identity)
+		if err := op.patch.Update.applyTo(updated); err != nil {
+			return err
+		}
+
+		if err := m.validate(ctx, updated, o); err != nil {
+			return err
+		}
+
+		if err := m.requiresPrivilegedAccess(ctx, original, updated, o); err != nil {
+			return err
+		}
+
+		op.identity = updated
+	case BatchIdentityPatchActionDelete:
+		if op.patch.ID == uuid.Nil {
+			return errors.WithStack(herodot.ErrBadRequest.WithReason("Deleting an identity requires field `id`."))
+		}
+	default:
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("Unknown action `%s`, expected one of `create`, `update`, or `delete`.", op.patch.Action))
+	}
+
+	return nil
+}
+
+func (m *Manager) executeBatchOperation(ctx context.Context, op *batchOperation) error {
+	pool := m.r.IdentityPool().(PrivilegedPool)
+	switch op.patch.Action {
+	case BatchIdentityPatchActionCreate:
+		return pool.CreateIdentity(ctx, op.identity)
+	case BatchIdentityPatchActionUpdate:
+		return pool.UpdateIdentity(ctx, op.identity)
+	default:
+		return pool.DeleteIdentity(ctx, op.patch.ID)
+	}
+}
+
+func (op *batchOperation) succeeded() {
+	if op.identity == nil {
+		id := op.patch.ID
+		op.result.ID = &id
+		return
+	}
+
+	id := op.identity.ID
+	op.result.ID = &id
+	op.result.Identity = op.identity
+}
+
+// transaction runs callback in a transaction if the identity pool supports transactions.
+func (m *Manager) transaction(ctx context.Context, callback func(ctx context.Context) error) error {
+	t, ok := m.r.IdentityPool().(Transactor)
+	if !ok {
+		return callback(ctx)
+	}
+
+	return t.Transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+		return callback(ctx)
+	})
+}
@@ -0,0 +1,171 @@
+package identity
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/ory/go-convenience/stringslice"
+	"github.com/ory/herodot"
+)
+
+// Credentials without their secrets
+//
+// swagger:model identityCredentialsMetadata
+type CredentialsMetadata struct {
+	// Type discriminates between different types of credentials.
+	//
+	// required: true
+	Type CredentialsType `json:"type"`
+
+	// Identifiers represents a list of unique identifiers this credential type matches.
+	//
+	// required: true
+	Identifiers []string `json:"identifiers"`
+
+	// Items lists the parts of this credential which can be removed individually. These are the linked
+	// providers of `oidc` credentials and the security keys of `webauthn` credentials.
+	//
+	// required: true
+	Items []CredentialsMetadataItem `json:"items"`
+
+	// CreatedAt is the time at which the credential was created.
+	//
+	// required: true
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time at which the credential was last updated.
+	//
+	// required: true
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// A linked OpenID Connect provider or a WebAuthn security key
+//
+// swagger:model identityCredentialsMetadataItem
+type CredentialsMetadataItem struct {
+	// ID identifies the item within its credential. For `oidc` credentials it is the provider and the subject
+	// separated by a colon, for `webauthn` credentials it is the URL-safe base64 encoding of the key's ID.
+	//
+	// required: true
+	ID string `json:"id"`
+
+	// Provider is the ID of the linked OpenID Connect provider.
+	Provider string `json:"provider,omitempty"`
+
+	// Subject is the ID of the identity at the linked OpenID Connect provider.
+	Subject string `json:"subject,omitempty"`
+
+	// DisplayName is the name the identity gave the WebAuthn security key.
+	DisplayName string `json:"display_name,omitempty"`
+
+	// AddedAt is the time at which the WebAuthn security key was added.
+	AddedAt *time.Time `json:"added_at,omitempty"`
+}
+
+// NewCredentialsMetadata returns the metadata of c without any secrets such as hashes, tokens, or keys.
+func NewCredentialsMetadata(c Credentials) *CredentialsMetadata {
+	m := &CredentialsMetadata{
+		Type:        c.Type,
+		Identifiers: c.Identifiers,
+		Items:       []CredentialsMetadataItem{},
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+	if m.Identifiers == nil {
+		m.Identifiers = []string{}
+	}
+
+	switch c.Type {
+	case CredentialsTypeOIDC:
+		gjson.GetBytes(c.Config, "providers").ForEach(func(_, v gjson.Result) bool {
+			provider, subject := v.Get("provider").String(), v.Get("subject").String()
+			m.Items = append(m.Items, CredentialsMetadataItem{
+				ID:       OIDCUniqueID(provider, subject),
+				Provider: provider,
+				Subject:  subject,
+			})
+			return true
+		})
+	case CredentialsTypeWebAuthn:
+		gjson.GetBytes(c.Config, "credentials").ForEach(func(_, v gjson.Result) bool {
+			item := CredentialsMetadataItem{
+				ID:          webAuthnItemID(v),
+				DisplayName: v.Get("display_name").String(),
+			}
+			if addedAt := v.Get("added_at").Time(); !addedAt.IsZero() {
+				item.AddedAt = &addedAt
+			}
+			m.Items = append(m.Items, item)
+			return true
+		})
+	}
+
+	return m
+}
+
+// webAuthnItemID returns the URL-safe encoding of a WebAuthn key's ID which is stored using standard base64.
+func webAuthnItemID(key gjson.Result) string {
+	id, err := base64.StdEncoding.DecodeString(key.Get("id").String())
+	if err != nil {
+		return key.Get("id").String()
+	}
+	return base64.RawURLEncoding.EncodeToString(id)
+}
+
+// DeleteCredentialsItem removes a single linked OpenID Connect provider or WebAuthn security key, identified as in
+// CredentialsMetadataItem, from the credentials of type t. The credentials are removed entirely if no item is left.
+func (i *Identity) DeleteCredentialsItem(t CredentialsType, itemID string) error {
+	c, ok := i.GetCredentials(t)
+	if !ok {
+		return errors.WithStack(herodot.ErrNotFound.WithReasonf("The identity has no %s credentials.", t))
+	}
+
+	var path string
+	var id func(v gjson.Result) string
+	switch t {
+	case CredentialsTypeOIDC:
+		path = "providers"
+		id = func(v gjson.Result) string {
+			return OIDCUniqueID(v.Get("provider").String(), v.Get("subject").String())
+		}
+	case CredentialsTypeWebAuthn:
+		path = "credentials"
+		id = webAuthnItemID
+	default:
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("Credentials of type %s do not have items which can be removed individually.", t))
+	}
+
+	items := gjson.GetBytes(c.Config, path).Array()
+	for k, v := range items {
+		if id(v) != itemID {
+			continue
+		}
+
+		if len(items) == 1 {
+			i.DeleteCredentialsType(t)
+			return nil
+		}
+
+		config, err := sjson.DeleteBytes(c.Config, fmt.Sprintf("%s.%d", path, k))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		c.Config = config
+
+		if t == CredentialsTypeOIDC {
+			c.Identifiers = stringslice.Filter(c.Identifiers, func(s string) bool {
+				return s == itemID
+			})
+		}
+
+		i.SetCredentials(t, *c)
+		return nil
+	}
+
+	return errors.WithStack(herodot.ErrNotFound.WithReasonf("The %s credentials have no item with ID %s.", t, itemID))
+}
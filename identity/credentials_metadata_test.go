@@ -0,0 +1,95 @@
+package identity
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/sqlxx"
+)
+
+func TestNewCredentialsMetadata(t *testing.T) {
+	t.Run("type=password", func(t *testing.T) {
+		m := NewCredentialsMetadata(Credentials{
+			Type:        CredentialsTypePassword,
+			Identifiers: []string{"foo@ory.sh"},
+			Config:      sqlxx.JSONRawMessage(`{"hashed_password":"secret"}`),
+		})
+		assert.Equal(t, []string{"foo@ory.sh"}, m.Identifiers)
+		assert.Empty(t, m.Items)
+	})
+
+	t.Run("type=oidc", func(t *testing.T) {
+		m := NewCredentialsMetadata(Credentials{
+			Type:        CredentialsTypeOIDC,
+			Identifiers: []string{"github:1234"},
+			Config:      sqlxx.JSONRawMessage(`{"providers":[{"provider":"github","subject":"1234","initial_access_token":"secret"}]}`),
+		})
+		assert.Equal(t, []CredentialsMetadataItem{{ID: "github:1234", Provider: "github", Subject: "1234"}}, m.Items)
+	})
+
+	t.Run("type=webauthn", func(t *testing.T) {
+		m := NewCredentialsMetadata(Credentials{
+			Type:   CredentialsTypeWebAuthn,
+			Config: sqlxx.JSONRawMessage(`{"credentials":[{"id":"+/8=","public_key":"c2VjcmV0","display_name":"YubiKey","added_at":"2021-10-22T12:00:00Z"}]}`),
+		})
+		assert.Equal(t, []string{}, m.Identifiers)
+		require.Len(t, m.Items, 1)
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString([]byte{0xfb, 0xff}), m.Items[0].ID)
+		assert.Equal(t, "YubiKey", m.Items[0].DisplayName)
+		require.NotNil(t, m.Items[0].AddedAt)
+		assert.Equal(t, 2021, m.Items[0].AddedAt.Year())
+	})
+}
+
+func TestDeleteCredentialsItem(t *testing.T) {
+	newIdentity := func() *Identity {
+		i := NewIdentity("")
+		i.SetCredentials(CredentialsTypeOIDC, Credentials{
+			Identifiers: []string{"github:1234", "google:5678"},
+			Config:      sqlxx.JSONRawMessage(`{"providers":[{"provider":"github","subject":"1234"},{"provider":"google","subject":"5678"}]}`),
+		})
+		i.SetCredentials(CredentialsTypeWebAuthn, Credentials{
+			Identifiers: []string{i.ID.String()},
+			Config:      sqlxx.JSONRawMessage(`{"credentials":[{"id":"AQ==","display_name":"YubiKey"}]}`),
+		})
+		i.SetCredentials(CredentialsTypeTOTP, Credentials{
+			Config: sqlxx.JSONRawMessage(`{"totp_url":"otpauth://totp/foo"}`),
+		})
+		return i
+	}
+
+	t.Run("case=removes an oidc provider and its identifier", func(t *testing.T) {
+		i := newIdentity()
+		require.NoError(t, i.DeleteCredentialsItem(CredentialsTypeOIDC, "github:1234"))
+
+		c, ok := i.GetCredentials(CredentialsTypeOIDC)
+		require.True(t, ok)
+		assert.Equal(t, []string{"google:5678"}, c.Identifiers)
+		assert.Equal(t, "google", gjson.GetBytes(c.Config, "providers.0.provider").String())
+		assert.Len(t, gjson.GetBytes(c.Config, "providers").Array(), 1)
+	})
+
+	t.Run("case=removes the credentials with the last item", func(t *testing.T) {
+		i := newIdentity()
+		require.NoError(t, i.DeleteCredentialsItem(CredentialsTypeWebAuthn, "AQ"))
+
+		_, ok := i.GetCredentials(CredentialsTypeWebAuthn)
+		assert.False(t, ok)
+	})
+
+	t.Run("case=fails for unknown items", func(t *testing.T) {
+		i := newIdentity()
+		assert.ErrorIs(t, i.DeleteCredentialsItem(CredentialsTypeOIDC, "github:5678"), herodot.ErrNotFound)
+		assert.ErrorIs(t, i.DeleteCredentialsItem(CredentialsTypePassword, "foo"), herodot.ErrNotFound)
+	})
+
+	t.Run("case=fails for credentials without items", func(t *testing.T) {
+		i := newIdentity()
+		assert.ErrorIs(t, i.DeleteCredentialsItem(CredentialsTypeTOTP, "foo"), herodot.ErrBadRequest)
+	})
+}
@@ -0,0 +1,22 @@
+package identity
+
+import "fmt"
+
+// CredentialsOIDC contains the configuration for credentials of the type oidc.
+type CredentialsOIDC struct {
+	Providers []CredentialsOIDCProvider `json:"providers"`
+}
+
+// CredentialsOIDCProvider contains a specific OpenID Connect credential for a particular connection (e.g. Google).
+type CredentialsOIDCProvider struct {
+	Subject             string `json:"subject"`
+	Provider            string `json:"provider"`
+	InitialIDToken      string `json:"initial_id_token"`
+	InitialAccessToken  string `json:"initial_access_token"`
+	InitialRefreshToken string `json:"initial_refresh_token"`
+}
+
+// OIDCUniqueID returns the credentials identifier of an OpenID Connect connection.
+func OIDCUniqueID(provider, subject string) string {
+	return fmt.Sprintf("%s:%s", provider, subject)
+}
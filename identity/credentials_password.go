@@ -0,0 +1,7 @@
+package identity
+
+// CredentialsPassword contains the configuration for credentials of the type password.
+type CredentialsPassword struct {
+	// HashedPassword is a hash-representation of the password.
+	HashedPassword string `json:"hashed_password"`
+}
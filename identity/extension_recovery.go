@@ -24,24 +24,20 @@ func (r *SchemaExtensionRecovery) Run(ctx jsonschema.ValidationContext, s schema
 	defer r.l.Unlock()
 
 	switch s.Recovery.Via {
-	case "email":
+	case AddressTypeEmail:
 		if !jsonschema.Formats["email"](value) {
 			return ctx.Error("format", "%q is not valid %q", value, "email")
 		}
 
-		address := NewRecoveryEmailAddress(fmt.Sprintf("%s", value), r.i.ID)
-
-		if has := r.has(r.i.RecoveryAddresses, address); has != nil {
-			if r.has(r.v, address) == nil {
-				r.v = append(r.v, *has)
-			}
-			return nil
-		}
-
-		if has := r.has(r.v, address); has == nil {
-			r.v = append(r.v, *address)
+		r.add(NewRecoveryEmailAddress(fmt.Sprintf("%s", value), r.i.ID))
+		return nil
+	case AddressTypePhone:
+		normalized, err := NormalizePhoneNumber(fmt.Sprintf("%s", value))
+		if err != nil {
+			return ctx.Error("format", "%q is not a valid phone number in E.164 format", value)
 		}
 
+		r.add(NewRecoverySMSAddress(normalized, r.i.ID))
 		return nil
 	case "":
 		return nil
@@ -50,6 +46,19 @@ func (r *SchemaExtensionRecovery) Run(ctx jsonschema.ValidationContext, s schema
 	return ctx.Error("", "recovery.via has unknown value %q", s.Recovery.Via)
 }
 
+func (r *SchemaExtensionRecovery) add(address *RecoveryAddress) {
+	if has := r.has(r.i.RecoveryAddresses, address); has != nil {
+		if r.has(r.v, address) == nil {
+			r.v = append(r.v, *has)
+		}
+		return
+	}
+
+	if has := r.has(r.v, address); has == nil {
+		r.v = append(r.v, *address)
+	}
+}
+
 func (r *SchemaExtensionRecovery) has(haystack []RecoveryAddress, needle *RecoveryAddress) *RecoveryAddress {
 	for _, has := range haystack {
 		if has.Value == needle.Value && has.Via == needle.Via {
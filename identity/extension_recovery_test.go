@@ -137,6 +137,34 @@ func TestSchemaExtensionRecovery(t *testing.T) {
 				},
 			},
 		},
+		{
+			doc:    `{"email":"foo@ory.sh","phone":"+49 (176) 123-45678"}`,
+			schema: "file://./stub/extension/recovery/phone.schema.json",
+			expect: []RecoveryAddress{
+				{
+					Value:      "foo@ory.sh",
+					Via:        RecoveryAddressTypeEmail,
+					IdentityID: iid,
+				},
+				{
+					Value:      "+4917612345678",
+					Via:        RecoveryAddressTypeSMS,
+					IdentityID: iid,
+				},
+			},
+			existing: []RecoveryAddress{
+				{
+					Value:      "+4917612345678",
+					Via:        RecoveryAddressTypeSMS,
+					IdentityID: iid,
+				},
+			},
+		},
+		{
+			doc:       `{"phone":"017612345678"}`,
+			schema:    "file://./stub/extension/recovery/phone.schema.json",
+			expectErr: errors.New("I[#/phone] S[#/properties/phone/format] \"017612345678\" is not a valid phone number in E.164 format"),
+		},
 	} {
 		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
 			id := &Identity{ID: iid, RecoveryAddresses: tc.existing}
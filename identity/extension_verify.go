@@ -26,24 +26,20 @@ func (r *SchemaExtensionVerification) Run(ctx jsonschema.ValidationContext, s sc
 	defer r.l.Unlock()
 
 	switch s.Verification.Via {
-	case "email":
+	case AddressTypeEmail:
 		if !jsonschema.Formats["email"](value) {
 			return ctx.Error("format", "%q is not valid %q", value, "email")
 		}
 
-		address := NewVerifiableEmailAddress(fmt.Sprintf("%s", value), r.i.ID)
-
-		if has := r.has(r.i.VerifiableAddresses, address); has != nil {
-			if r.has(r.v, address) == nil {
-				r.v = append(r.v, *has)
-			}
-			return nil
-		}
-
-		if has := r.has(r.v, address); has == nil {
-			r.v = append(r.v, *address)
+		r.add(NewVerifiableEmailAddress(fmt.Sprintf("%s", value), r.i.ID))
+		return nil
+	case AddressTypePhone:
+		normalized, err := NormalizePhoneNumber(fmt.Sprintf("%s", value))
+		if err != nil {
+			return ctx.Error("format", "%q is not a valid phone number in E.164 format", value)
 		}
 
+		r.add(NewVerifiableSMSAddress(normalized, r.i.ID))
 		return nil
 	case "":
 		return nil
@@ -52,6 +48,19 @@ func (r *SchemaExtensionVerification) Run(ctx jsonschema.ValidationContext, s sc
 	return ctx.Error("", "verification.via has unknown value %q", s.Verification.Via)
 }
 
+func (r *SchemaExtensionVerification) add(address *VerifiableAddress) {
+	if has := r.has(r.i.VerifiableAddresses, address); has != nil {
+		if r.has(r.v, address) == nil {
+			r.v = append(r.v, *has)
+		}
+		return
+	}
+
+	if has := r.has(r.v, address); has == nil {
+		r.v = append(r.v, *address)
+	}
+}
+
 func (r *SchemaExtensionVerification) has(haystack []VerifiableAddress, needle *VerifiableAddress) *VerifiableAddress {
 	for _, has := range haystack {
 		if has.Value == needle.Value && has.Via == needle.Via {
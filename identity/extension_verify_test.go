@@ -166,6 +166,40 @@ func TestSchemaExtensionVerification(t *testing.T) {
 				},
 			},
 		},
+		{
+			doc:    `{"email":"foo@ory.sh","phone":"0049 176 12345678"}`,
+			schema: "file://./stub/extension/verify/phone.schema.json",
+			expect: []VerifiableAddress{
+				{
+					Value:      "foo@ory.sh",
+					Verified:   false,
+					Status:     VerifiableAddressStatusPending,
+					Via:        VerifiableAddressTypeEmail,
+					IdentityID: iid,
+				},
+				{
+					Value:      "+4917612345678",
+					Verified:   true,
+					Status:     VerifiableAddressStatusCompleted,
+					Via:        VerifiableAddressTypeSMS,
+					IdentityID: iid,
+				},
+			},
+			existing: []VerifiableAddress{
+				{
+					Value:      "+4917612345678",
+					Verified:   true,
+					Status:     VerifiableAddressStatusCompleted,
+					Via:        VerifiableAddressTypeSMS,
+					IdentityID: iid,
+				},
+			},
+		},
+		{
+			doc:       `{"phone":"+49 176 1234567a"}`,
+			schema:    "file://./stub/extension/verify/phone.schema.json",
+			expectErr: errors.New("I[#/phone] S[#/properties/phone/format] \"+49 176 1234567a\" is not a valid phone number in E.164 format"),
+		},
 	} {
 		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
 			id := &Identity{ID: iid, VerifiableAddresses: tc.existing}
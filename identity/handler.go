@@ -3,7 +3,11 @@ package identity
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ory/kratos/x"
@@ -12,6 +16,7 @@ import (
 
 	"github.com/ory/herodot"
 
+	"github.com/gofrs/uuid"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
@@ -20,10 +25,23 @@ import (
 	"github.com/ory/x/urlx"
 
 	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/hash"
+	"github.com/ory/kratos/schema"
 )
 
 const RouteCollection = "/identities"
 const RouteItem = RouteCollection + "/:id"
+const RouteRestore = RouteItem + "/restore"
+const RouteHistory = RouteItem + "/history"
+const RouteCredentials = RouteItem + "/credentials"
+
+// RouteExternalID serves `/identities/by/external_id/:external_id`. httprouter does not allow a static segment
+// next to the `:id` wildcard, so the route is registered below RouteItem and the handler checks that `:id` is `by`.
+const RouteExternalID = RouteItem + "/external_id/:external_id"
+const RouteSchemaMigrate = "/" + schema.SchemasPath + "/:id/migrate"
+const RouteVerifiableAddresses = "/verifiable-addresses"
+const RouteVerifiableAddress = RouteVerifiableAddresses + "/:id"
+const RouteRecoveryAddresses = "/recovery-addresses"
 
 type (
 	handlerDependencies interface {
@@ -34,6 +52,7 @@ type (
 		config.Provider
 		x.CSRFProvider
 		cipher.Provider
+		hash.HashProvider
 	}
 	HandlerProvider interface {
 		IdentityHandler() *Handler
@@ -52,21 +71,43 @@ func NewHandler(r handlerDependencies) *Handler {
 }
 
 func (h *Handler) RegisterPublicRoutes(public *x.RouterPublic) {
-	h.r.CSRFHandler().IgnoreGlobs(RouteCollection, RouteCollection+"/*")
+	h.r.CSRFHandler().IgnoreGlobs(RouteCollection, RouteCollection+"/*", RouteCollection+"/*/restore", RouteCollection+"/*/history", RouteCollection+"/*/credentials", RouteCollection+"/*/external_id/*", "/"+schema.SchemasPath+"/*/migrate", RouteVerifiableAddresses, RouteVerifiableAddresses+"/*", RouteRecoveryAddresses)
 	public.GET(RouteCollection, x.RedirectToAdminRoute(h.r))
 	public.GET(RouteItem, x.RedirectToAdminRoute(h.r))
 	public.DELETE(RouteItem, x.RedirectToAdminRoute(h.r))
 	public.POST(RouteCollection, x.RedirectToAdminRoute(h.r))
 	public.PUT(RouteItem, x.RedirectToAdminRoute(h.r))
+	public.PATCH(RouteCollection, x.RedirectToAdminRoute(h.r))
+	public.PATCH(RouteItem, x.RedirectToAdminRoute(h.r))
+	public.POST(RouteRestore, x.RedirectToAdminRoute(h.r))
+	public.GET(RouteHistory, x.RedirectToAdminRoute(h.r))
+	public.GET(RouteCredentials, x.RedirectToAdminRoute(h.r))
+	public.GET(RouteExternalID, x.RedirectToAdminRoute(h.r))
+	public.POST(RouteSchemaMigrate, x.RedirectToAdminRoute(h.r))
+	public.GET(RouteVerifiableAddresses, x.RedirectToAdminRoute(h.r))
+	public.PUT(RouteVerifiableAddress, x.RedirectToAdminRoute(h.r))
+	public.GET(RouteRecoveryAddresses, x.RedirectToAdminRoute(h.r))
 }
 
 func (h *Handler) RegisterAdminRoutes(admin *x.RouterAdmin) {
 	admin.GET(RouteCollection, h.list)
 	admin.GET(RouteItem, h.get)
+	admin.GET(RouteExternalID, h.getByExternalID)
 	admin.DELETE(RouteItem, h.delete)
 
+	admin.GET(RouteHistory, h.history)
+	admin.GET(RouteCredentials, h.listCredentials)
+
 	admin.POST(RouteCollection, h.create)
-	admin.PUT(RouteItem, h.update)
+	admin.PUT(RouteItem, AttributeChangesTo(HistoryCauseAdminAPI, h.update))
+	admin.PATCH(RouteCollection, AttributeChangesTo(HistoryCauseAdminAPI, h.batchPatch))
+	admin.PATCH(RouteItem, AttributeChangesTo(HistoryCauseAdminAPI, h.patch))
+	admin.POST(RouteRestore, h.restore)
+	admin.POST(RouteSchemaMigrate, AttributeChangesTo(HistoryCauseAdminAPI, h.migrateSchema))
+
+	admin.GET(RouteVerifiableAddresses, h.listVerifiableAddresses)
+	admin.PUT(RouteVerifiableAddress, h.updateVerifiableAddress)
+	admin.GET(RouteRecoveryAddresses, h.listRecoveryAddresses)
 }
 
 // A list of identities.
@@ -90,18 +131,101 @@ type adminListIdentities struct {
 
 	// Pagination Page
 	//
+	// This parameter is deprecated. It is the zero-based page number used for offset pagination, which
+	// gets slow and inconsistent on large tables. Use `page_token` instead.
+	//
 	// required: false
 	// in: query
 	// default: 0
 	// min: 0
 	Page int `json:"page"`
+
+	// Page Token
+	//
+	// The token of the page to return. Omit it to get the first page. The token of the next page is part
+	// of the `next` relation in the `Link` response header. Tokens are opaque and must not be constructed
+	// by the client.
+	//
+	// required: false
+	// in: query
+	PageToken string `json:"page_token"`
+
+	// CredentialsIdentifier
+	//
+	// Only return identities having a credential with exactly this identifier (e.g. an email address).
+	//
+	// required: false
+	// in: query
+	CredentialsIdentifier string `json:"credentials_identifier"`
+
+	// CredentialsIdentifierPrefix
+	//
+	// Only return identities having a credential with an identifier starting with this value.
+	//
+	// required: false
+	// in: query
+	CredentialsIdentifierPrefix string `json:"credentials_identifier_prefix"`
+
+	// State
+	//
+	// Only return identities in this state.
+	//
+	// required: false
+	// in: query
+	State string `json:"state"`
+
+	// SchemaID
+	//
+	// Only return identities using this identity schema.
+	//
+	// required: false
+	// in: query
+	SchemaID string `json:"schema_id"`
+
+	// CreatedAfter
+	//
+	// Only return identities created at or after this RFC 3339 timestamp.
+	//
+	// required: false
+	// in: query
+	// format: date-time
+	CreatedAfter string `json:"created_after"`
+
+	// CreatedBefore
+	//
+	// Only return identities created at or before this RFC 3339 timestamp.
+	//
+	// required: false
+	// in: query
+	// format: date-time
+	CreatedBefore string `json:"created_before"`
+
+	// Traits
+	//
+	// Only return identities whose trait at the given path equals the value. This filter is free-form: each
+	// key is a query parameter made of `traits.` followed by the path to the trait, for example
+	// `traits.email=foo@example.com` or `traits.name.first=Jane`. Path segments must start with a letter or `_`
+	// and may only contain letters, digits, and `_`. Values are always compared as strings, so `traits.age=30`
+	// matches the number `30` and `traits.newsletter=true` matches the boolean `true`. Each path may only be
+	// given once.
+	//
+	// required: false
+	// in: query
+	Traits map[string]string `json:"traits"`
 }
 
 // swagger:route GET /identities v0alpha2 adminListIdentities
 //
 // List Identities
 //
-// Lists all identities. Does not support search at the moment.
+// Lists all identities. The result can be narrowed down using the query parameters documented below. Additionally,
+// identities can be filtered by their traits using query parameters prefixed with `traits.`, for example
+// `traits.email=foo@example.com` or `traits.name.first=Jane`. Trait values are matched as strings. All filters,
+// including `credentials_identifier` and `credentials_identifier_prefix`, are combined using AND.
+//
+// Identities are ordered by descending ID and paginated using the `page_token` query parameter. The `Link` response
+// header contains the URL of the first page and, unless this is the last page, of the next page. The deprecated
+// `page` query parameter switches to offset pagination, which is the only mode also returning `X-Total-Count`.
 //
 // Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
 //
@@ -115,23 +239,98 @@ type adminListIdentities struct {
 //
 //     Responses:
 //       200: identityList
+//       400: jsonError
 //       500: jsonError
 func (h *Handler) list(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	page, itemsPerPage := x.ParsePagination(r)
-	is, err := h.r.IdentityPool().ListIdentities(r.Context(), page, itemsPerPage)
+	filter, err := parseListIdentitiesFilter(r)
 	if err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
-	total, err := h.r.IdentityPool().CountIdentities(r.Context())
+	page, err := x.ParsePage(r)
 	if err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
-	x.PaginationHeader(w, urlx.AppendPaths(h.r.Config(r.Context()).SelfAdminURL(), RouteCollection), total, page, itemsPerPage)
-	h.r.Writer().Write(w, r, is)
+	is, err := h.r.IdentityPool().ListIdentities(r.Context(), *filter, page)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	u := urlx.AppendPaths(h.r.Config(r.Context()).SelfAdminURL(), RouteCollection)
+	u.RawQuery = r.URL.RawQuery
+
+	if page.UseOffset {
+		// Counting is expensive on large tables which is why it is only done for the deprecated offset pagination.
+		total, err := h.r.IdentityPool().CountIdentities(r.Context(), *filter)
+		if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+
+		x.PaginationHeader(w, u, total, page.Offset, page.ItemsPerPage)
+		h.r.Writer().Write(w, r, withAdminMetadataInJSON(is))
+		return
+	}
+
+	var last uuid.UUID
+	if len(is) > 0 {
+		last = is[len(is)-1].ID
+	}
+	x.KeysetPaginationHeader(w, u, page, len(is), last)
+	h.r.Writer().Write(w, r, withAdminMetadataInJSON(is))
+}
+
+func withAdminMetadataInJSON(is []Identity) []WithAdminMetadataInJSON {
+	out := make([]WithAdminMetadataInJSON, len(is))
+	for k := range is {
+		out[k] = WithAdminMetadataInJSON(is[k])
+	}
+	return out
+}
+
+func parseListIdentitiesFilter(r *http.Request) (*ListIdentitiesFilter, error) {
+	query := r.URL.Query()
+	filter := ListIdentitiesFilter{
+		CredentialsIdentifier:       query.Get("credentials_identifier"),
+		CredentialsIdentifierPrefix: query.Get("credentials_identifier_prefix"),
+		SchemaID:                    query.Get("schema_id"),
+		Traits:                      map[string]string{},
+	}
+
+	if state := State(query.Get("state")); state != "" {
+		if err := state.IsValid(); err != nil {
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Invalid value `%s` for parameter `state`.", state).WithWrap(err))
+		}
+		filter.State = state
+	}
+
+	for key, target := range map[string]*time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+	} {
+		if value := query.Get(key); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Invalid value `%s` for parameter `%s`, expected an RFC 3339 timestamp.", value, key).WithWrap(err))
+			}
+			*target = t
+		}
+	}
+
+	for key, values := range query {
+		if path := strings.TrimPrefix(key, "traits."); path != key {
+			if len(values) > 1 {
+				return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Parameter `%s` must not be repeated.", key))
+			}
+			filter.Traits[path] = values[0]
+		}
+	}
+
+	return &filter, nil
 }
 
 // swagger:parameters adminGetIdentity
@@ -157,6 +356,9 @@ type adminGetIdentity struct {
 //
 // Get an Identity
 //
+// The identity's current version is returned in the `ETag` header. Send it in the `If-Match` header when updating
+// the identity to make sure that no one else modified the identity in the meantime.
+//
 // Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
 //
 //     Consumes:
@@ -187,7 +389,8 @@ func (h *Handler) get(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
-		h.r.Writer().Write(w, r, WithCredentialsInJSON(*emit))
+		w.Header().Set("ETag", etag(emit))
+		h.r.Writer().Write(w, r, WithCredentialsAndAdminMetadataInJSON(*emit))
 		return
 	} else if len(declassify) > 0 {
 		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Invalid value `%s` for parameter `include_credential`.", declassify)))
@@ -195,7 +398,61 @@ func (h *Handler) get(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 
 	}
 
-	h.r.Writer().Write(w, r, WithCredentialsMetadataInJSON(*i))
+	w.Header().Set("ETag", etag(i))
+	h.r.Writer().Write(w, r, WithCredentialsMetadataAndAdminMetadataInJSON(*i))
+}
+
+// swagger:parameters adminGetIdentityByExternalId
+// nolint:deadcode,unused
+type adminGetIdentityByExternalId struct {
+	// ExternalID is the identity's external ID.
+	//
+	// required: true
+	// in: path
+	ExternalID string `json:"external_id"`
+}
+
+// swagger:route GET /identities/by/external_id/{external_id} v0alpha2 adminGetIdentityByExternalId
+//
+// Get an Identity by its External ID
+//
+// This endpoint returns the identity with the given external ID. The identity's current version is returned in
+// the `ETag` header.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: identity
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) getByExternalID(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if ps.ByName("id") != "by" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound))
+		return
+	}
+
+	found, err := h.r.IdentityPool().FindIdentityByExternalID(r.Context(), ps.ByName("external_id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	i, err := h.r.PrivilegedIdentityPool().GetIdentityConfidential(r.Context(), found.ID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(i))
+	h.r.Writer().Write(w, r, WithCredentialsMetadataAndAdminMetadataInJSON(*i))
 }
 
 // swagger:parameters adminCreateIdentity
@@ -223,6 +480,52 @@ type AdminCreateIdentityBody struct {
 	//
 	// required: false
 	State State `json:"state"`
+
+	// Credentials represents all credentials that should be imported for this identity.
+	//
+	// required: false
+	Credentials *AdminIdentityImportCredentials `json:"credentials"`
+
+	// Store metadata about the identity which the identity itself can see when calling for example the
+	// session endpoint. Do not store sensitive information (e.g. credit score) about the identity in this field.
+	MetadataPublic json.RawMessage `json:"metadata_public,omitempty"`
+
+	// Store metadata about the user which is only accessible through admin APIs such as `GET /admin/identities/<id>`.
+	MetadataAdmin json.RawMessage `json:"metadata_admin,omitempty"`
+
+	// ExternalID is a unique identifier of the identity in another system, e.g. the ID of the customer
+	// in a billing system. The identity can be fetched using `GET /identities/by/external_id/{external_id}`.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+func (cr *AdminCreateIdentityBody) toIdentity(ctx context.Context, hasher hash.Hasher) (*Identity, error) {
+	stateChangedAt := sqlxx.NullTime(time.Now())
+	state := StateActive
+	if cr.State != "" {
+		if err := cr.State.IsValid(); err != nil {
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err))
+		}
+		state = cr.State
+	}
+
+	if err := validateExternalID(cr.ExternalID); err != nil {
+		return nil, err
+	}
+
+	i := &Identity{
+		SchemaID:       cr.SchemaID,
+		Traits:         []byte(cr.Traits),
+		State:          state,
+		StateChangedAt: &stateChangedAt,
+		MetadataPublic: []byte(cr.MetadataPublic),
+		MetadataAdmin:  []byte(cr.MetadataAdmin),
+		ExternalID:     sqlxx.NullString(cr.ExternalID),
+	}
+	if err := cr.Credentials.importTo(ctx, hasher, i); err != nil {
+		return nil, err
+	}
+
+	return i, nil
 }
 
 // swagger:route POST /identities v0alpha2 adminCreateIdentity
@@ -257,31 +560,104 @@ func (h *Handler) create(w http.ResponseWriter, r *http.Request, _ httprouter.Pa
 		return
 	}
 
-	stateChangedAt := sqlxx.NullTime(time.Now())
-	state := StateActive
-	if cr.State != "" {
-		if err := cr.State.IsValid(); err != nil {
-			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err)))
-			return
-		}
-		state = cr.State
+	i, err := cr.toIdentity(r.Context(), h.r.Hasher())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
 	}
-	i := &Identity{SchemaID: cr.SchemaID, Traits: []byte(cr.Traits), State: state, StateChangedAt: &stateChangedAt}
+
 	if err := h.r.IdentityManager().Create(r.Context(), i); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
+	w.Header().Set("ETag", etag(i))
 	h.r.Writer().WriteCreated(w, r,
 		urlx.AppendPaths(
 			h.r.Config(r.Context()).SelfAdminURL(),
 			"identities",
 			i.ID.String(),
 		).String(),
-		i,
+		WithAdminMetadataInJSON(*i),
 	)
 }
 
+// swagger:parameters adminBatchPatchIdentities
+// nolint:deadcode,unused
+type adminBatchPatchIdentities struct {
+	// in: body
+	Body AdminBatchPatchIdentitiesBody
+}
+
+// swagger:model adminBatchPatchIdentitiesBody
+type AdminBatchPatchIdentitiesBody struct {
+	// Identities holds the patches to execute. At most 1000 patches are allowed per request.
+	//
+	// required: true
+	Identities []*BatchIdentityPatch `json:"identities"`
+}
+
+// Batch Patch Identities Response
+//
+// swagger:model batchPatchIdentitiesResponse
+type BatchPatchIdentitiesResponse struct {
+	// Identities contains one result per patch, in the same order as the patches.
+	Identities []*BatchIdentityPatchResult `json:"identities"`
+}
+
+// swagger:route PATCH /identities v0alpha2 adminBatchPatchIdentities
+//
+// Create, Update, and Delete Identities in a Batch
+//
+// This endpoint creates, updates, and deletes up to 1000 identities in a single request. Each patch is validated
+// against its identity schema like when calling the respective single identity endpoint. Valid patches are executed
+// in transactions of up to 100 patches.
+//
+// The response contains one result per patch, in the same order as the patches. A result either contains the
+// identity's ID and, unless it was deleted, the identity, or the error which prevented the patch from being executed.
+// One failing patch does not affect the other patches.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: batchPatchIdentitiesResponse
+//       400: jsonError
+//       500: jsonError
+func (h *Handler) batchPatch(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var body AdminBatchPatchIdentitiesBody
+	if err := jsonx.NewStrictDecoder(r.Body).Decode(&body); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err)))
+		return
+	}
+
+	if len(body.Identities) > BatchPatchIdentitiesMaxItems {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("A batch may contain at most %d patches but got %d.", BatchPatchIdentitiesMaxItems, len(body.Identities))))
+		return
+	}
+
+	for k, patch := range body.Identities {
+		if patch == nil {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Patch %d must not be null.", k)))
+			return
+		}
+	}
+
+	h.r.Writer().Write(w, r, &BatchPatchIdentitiesResponse{
+		Identities: h.r.IdentityManager().Batch(r.Context(), body.Identities, ManagerAllowWriteProtectedTraits),
+	})
+}
+
 // swagger:parameters adminUpdateIdentity
 // nolint:deadcode,unused
 type adminUpdateIdentity struct {
@@ -291,6 +667,11 @@ type adminUpdateIdentity struct {
 	// in: path
 	ID string `json:"id"`
 
+	// If set, the identity is only updated if its current `ETag` matches this value.
+	//
+	// in: header
+	IfMatch string `json:"If-Match"`
+
 	// in: body
 	Body AdminUpdateIdentityBody
 }
@@ -311,6 +692,57 @@ type AdminUpdateIdentityBody struct {
 	//
 	// required: true
 	State State `json:"state"`
+
+	// Store metadata about the identity which the identity itself can see when calling for example the
+	// session endpoint. Do not store sensitive information (e.g. credit score) about the identity in this field.
+	//
+	// The metadata is replaced with this value. If omitted, the metadata is removed.
+	MetadataPublic json.RawMessage `json:"metadata_public,omitempty"`
+
+	// Store metadata about the user which is only accessible through admin APIs such as `GET /admin/identities/<id>`.
+	//
+	// The metadata is replaced with this value. If omitted, the metadata is removed.
+	MetadataAdmin json.RawMessage `json:"metadata_admin,omitempty"`
+
+	// ExternalID is a unique identifier of the identity in another system, e.g. the ID of the customer
+	// in a billing system.
+	//
+	// The external ID is replaced with this value. If omitted, the external ID is removed.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+func (ur *AdminUpdateIdentityBody) applyTo(identity *Identity) error {
+	if ur.SchemaID != "" {
+		identity.SchemaID = ur.SchemaID
+	}
+
+	if ur.State != "" && identity.State != ur.State {
+		if err := ur.State.IsValid(); err != nil {
+			return errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err))
+		}
+
+		identity.SetState(ur.State)
+	}
+
+	if err := validateExternalID(ur.ExternalID); err != nil {
+		return err
+	}
+
+	identity.Traits = []byte(ur.Traits)
+	identity.MetadataPublic = []byte(ur.MetadataPublic)
+	identity.MetadataAdmin = []byte(ur.MetadataAdmin)
+	identity.ExternalID = sqlxx.NullString(ur.ExternalID)
+	return nil
+}
+
+// maxExternalIDLength is the size of the `external_id` column.
+const maxExternalIDLength = 255
+
+func validateExternalID(id string) error {
+	if len(id) > maxExternalIDLength {
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("The external ID must not be longer than %d characters.", maxExternalIDLength))
+	}
+	return nil
 }
 
 // swagger:route PUT /identities/{id} v0alpha2 adminUpdateIdentity
@@ -320,7 +752,11 @@ type AdminUpdateIdentityBody struct {
 // This endpoint updates an identity. It is NOT possible to set an identity's credentials (password, ...)
 // using this method! A way to achieve that will be introduced in the future.
 //
-// The full identity payload (except credentials) is expected. This endpoint does not support patching.
+// The full identity payload (except credentials) is expected. To modify only some fields, use
+// `PATCH /identities/{id}` instead.
+//
+// To prevent overwriting changes made by someone else, send the `ETag` returned when fetching the identity in the
+// `If-Match` header. If the identity was modified in the meantime, this endpoint returns 412.
 //
 // Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
 //
@@ -339,7 +775,8 @@ type AdminUpdateIdentityBody struct {
 //       200: identity
 //       400: jsonError
 //       404: jsonError
-//		 409: jsonError
+//       409: jsonError
+//       412: jsonError
 //       500: jsonError
 func (h *Handler) update(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var ur AdminUpdateIdentityBody
@@ -355,55 +792,65 @@ func (h *Handler) update(w http.ResponseWriter, r *http.Request, ps httprouter.P
 		return
 	}
 
-	if ur.SchemaID != "" {
-		identity.SchemaID = ur.SchemaID
+	if err := ur.applyTo(identity); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
 	}
 
-	if ur.State != "" && identity.State != ur.State {
-		if err := ur.State.IsValid(); err != nil {
-			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err)))
-			return
-		}
-
-		stateChangedAt := sqlxx.NullTime(time.Now())
-
-		identity.State = ur.State
-		identity.StateChangedAt = &stateChangedAt
+	opts, err := ifMatch(r, ManagerAllowWriteProtectedTraits)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
 	}
 
-	identity.Traits = []byte(ur.Traits)
 	if err := h.r.IdentityManager().Update(
 		r.Context(),
 		identity,
-		ManagerAllowWriteProtectedTraits,
+		opts...,
 	); err != nil {
-		h.r.Writer().WriteError(w, r, err)
+		h.r.Writer().WriteError(w, r, preconditionFailed(r, err))
 		return
 	}
 
-	h.r.Writer().Write(w, r, identity)
+	w.Header().Set("ETag", etag(identity))
+	h.r.Writer().Write(w, r, WithAdminMetadataInJSON(*identity))
 }
 
-// swagger:parameters adminDeleteIdentity
+// swagger:parameters adminPatchIdentity
 // nolint:deadcode,unused
-type adminDeleteIdentity struct {
-	// ID is the identity's ID.
+type adminPatchIdentity struct {
+	// ID must be set to the ID of identity you want to update
 	//
 	// required: true
 	// in: path
 	ID string `json:"id"`
+
+	// If set, the identity is only patched if its current `ETag` matches this value.
+	//
+	// in: header
+	IfMatch string `json:"If-Match"`
+
+	// in: body
+	Body JSONPatchDocument
 }
 
-// swagger:route DELETE /identities/{id} v0alpha2 adminDeleteIdentity
+// swagger:route PATCH /identities/{id} v0alpha2 adminPatchIdentity
 //
-// Delete an Identity
+// Patch an Identity
 //
-// Calling this endpoint irrecoverably and permanently deletes the identity given its ID. This action can not be undone.
-// This endpoint returns 204 when the identity was deleted or when the identity was not found, in which case it is
-// assumed that is has been deleted already.
+// This endpoint partially updates an identity using a [JSON Patch](https://jsonpatch.com/) (RFC 6902). Operations
+// may modify the identity's `traits`, `state`, `metadata_public`, `metadata_admin`, and `external_id`. The patched identity is
+// validated against its identity schema.
+// If a `test` operation fails, the identity is not modified and this endpoint returns 409.
+//
+// To prevent overwriting changes made by someone else, send the `ETag` returned when fetching the identity in the
+// `If-Match` header. If the identity was modified in the meantime, this endpoint returns 412.
 //
 // Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
 //
+//     Consumes:
+//     - application/json
+//
 //     Produces:
 //     - application/json
 //
@@ -413,7 +860,102 @@ type adminDeleteIdentity struct {
 //       oryAccessToken:
 //
 //     Responses:
-//       204: emptyResponse
+//       200: identity
+//       400: jsonError
+//       404: jsonError
+//       409: jsonError
+//       412: jsonError
+//       500: jsonError
+func (h *Handler) patch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Unable to read request body: %s", err).WithWrap(err)))
+		return
+	}
+
+	opts, err := ifMatch(r, ManagerAllowWriteProtectedTraits)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	identity, err := h.r.IdentityManager().Patch(r.Context(), x.ParseUUID(ps.ByName("id")), body, opts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, preconditionFailed(r, err))
+		return
+	}
+
+	w.Header().Set("ETag", etag(identity))
+	h.r.Writer().Write(w, r, WithAdminMetadataInJSON(*identity))
+}
+
+func etag(i *Identity) string {
+	return strconv.Quote(strconv.Itoa(i.Version))
+}
+
+// ifMatchTag returns the entity tag of the request's `If-Match` header, or an empty string if any version matches.
+func ifMatchTag(r *http.Request) string {
+	if tag := strings.TrimSpace(r.Header.Get("If-Match")); tag != "*" {
+		return tag
+	}
+	return ""
+}
+
+// ifMatch appends ManagerExpectVersion to the options if the request has an `If-Match` header.
+func ifMatch(r *http.Request, opts ...ManagerOption) ([]ManagerOption, error) {
+	tag := ifMatchTag(r)
+	if tag == "" {
+		return opts, nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(tag, `"`))
+	if err != nil {
+		// An entity tag we did not issue can never match.
+		return nil, errors.WithStack(x.ErrPreconditionFailed.WithReasonf("The If-Match header does not match the identity's ETag."))
+	}
+
+	return append(opts, ManagerExpectVersion(version)), nil
+}
+
+// preconditionFailed returns 412 instead of 409 if the request asked for a specific version using `If-Match`.
+func preconditionFailed(r *http.Request, err error) error {
+	if ifMatchTag(r) != "" && errors.Is(err, ErrConcurrentUpdate) {
+		return errors.WithStack(x.ErrPreconditionFailed.WithReasonf("The identity was modified in the meantime and no longer matches the If-Match header.").WithWrap(err))
+	}
+	return err
+}
+
+// swagger:parameters adminDeleteIdentity
+// nolint:deadcode,unused
+type adminDeleteIdentity struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+}
+
+// swagger:route DELETE /identities/{id} v0alpha2 adminDeleteIdentity
+//
+// Delete an Identity
+//
+// Calling this endpoint deletes the identity given its ID. Unless soft deletion is enabled, this action can not be undone.
+// Soft-deleted identities can be restored using `POST /identities/{id}/restore` until the retention period has passed.
+// This endpoint returns 204 when the identity was deleted or when the identity was not found, in which case it is
+// assumed that is has been deleted already.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       204: emptyResponse
 //       404: jsonError
 //       500: jsonError
 func (h *Handler) delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -424,3 +966,547 @@ func (h *Handler) delete(w http.ResponseWriter, r *http.Request, ps httprouter.P
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// swagger:parameters adminRestoreIdentity
+// nolint:deadcode,unused
+type adminRestoreIdentity struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+}
+
+// swagger:route POST /identities/{id}/restore v0alpha2 adminRestoreIdentity
+//
+// Restore a Deleted Identity
+//
+// Calling this endpoint restores an identity which was soft-deleted. Identities can only be restored until the
+// retention period configured in `identity.soft_delete.retention` has passed. Sessions which were revoked when
+// the identity was deleted are not restored.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: identity
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) restore(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := x.ParseUUID(ps.ByName("id"))
+	if err := h.r.PrivilegedIdentityPool().RestoreIdentity(r.Context(), id); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	i, err := h.r.PrivilegedIdentityPool().GetIdentityConfidential(r.Context(), id)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", etag(i))
+	h.r.Writer().Write(w, r, WithAdminMetadataInJSON(*i))
+}
+
+// swagger:parameters adminMigrateIdentitySchema
+// nolint:deadcode,unused
+type adminMigrateIdentitySchema struct {
+	// ID is the ID of the identity schema to migrate identities from.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// in: body
+	Body AdminMigrateIdentitySchemaBody
+}
+
+// swagger:model adminMigrateIdentitySchemaBody
+type AdminMigrateIdentitySchemaBody struct {
+	// ToSchemaID is the ID of the identity schema to migrate the identities to.
+	//
+	// required: true
+	ToSchemaID string `json:"to_schema_id"`
+
+	// Transform is Jsonnet code which transforms the traits of each identity. The identity is available
+	// as `std.extVar('identity')` and the code must return an object with key `identity.traits`. If empty,
+	// the traits are kept as they are.
+	Transform string `json:"transform"`
+
+	// DryRun, if true, transforms and validates the identities without updating them.
+	DryRun bool `json:"dry_run"`
+}
+
+// swagger:route POST /schemas/{id}/migrate v0alpha2 adminMigrateIdentitySchema
+//
+// Migrate Identities to Another Identity Schema
+//
+// This endpoint moves all identities using the identity schema given by its ID to another identity schema. The
+// traits of each identity are transformed using the optional Jsonnet code and validated against the target schema.
+// Identities which can not be transformed or which are invalid are reported and left untouched.
+//
+// Use `dry_run` to check which identities would fail the migration without updating any identity.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: identitySchemaMigrationReport
+//       400: jsonError
+//       500: jsonError
+func (h *Handler) migrateSchema(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var body AdminMigrateIdentitySchemaBody
+	if err := jsonx.NewStrictDecoder(r.Body).Decode(&body); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err)))
+		return
+	}
+
+	from := ps.ByName("id")
+	for _, id := range []string{from, body.ToSchemaID} {
+		if _, err := h.r.Config(r.Context()).IdentityTraitsSchemas().FindSchemaByID(id); err != nil {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Identity schema %q does not exist.", id)))
+			return
+		}
+	}
+
+	report, err := h.r.IdentityManager().MigrateSchema(r.Context(), from, body.ToSchemaID, body.Transform, body.DryRun)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, report)
+}
+
+// A list of identity changes.
+// swagger:model identityHistory
+// nolint:deadcode,unused
+type identityHistory []HistoryEntry
+
+// swagger:parameters adminListIdentityHistory
+// nolint:deadcode,unused
+type adminListIdentityHistory struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// Items per Page
+	//
+	// This is the number of items per page.
+	//
+	// required: false
+	// in: query
+	// default: 250
+	// min: 1
+	// max: 1000
+	PerPage int `json:"per_page"`
+
+	// Pagination Page
+	//
+	// required: false
+	// in: query
+	// default: 0
+	// min: 0
+	Page int `json:"page"`
+}
+
+// swagger:route GET /identities/{id}/history v0alpha2 adminListIdentityHistory
+//
+// List the Change History of an Identity
+//
+// This endpoint lists the recorded changes of an identity's traits, state, external ID, and credential identifiers,
+// newest first. Each change records what caused it, for example the admin API or a settings flow.
+//
+// Changes are only recorded if `identity.history.enabled` is set and are kept for the retention period configured
+// in `identity.history.retention`.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: identityHistory
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) history(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id := x.ParseUUID(ps.ByName("id"))
+	if _, err := h.r.IdentityPool().GetIdentity(r.Context(), id); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	page, itemsPerPage := x.ParsePagination(r)
+	entries, total, err := h.r.PrivilegedIdentityPool().ListIdentityHistory(r.Context(), id, page, itemsPerPage)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	u := urlx.AppendPaths(h.r.Config(r.Context()).SelfAdminURL(), RouteCollection, id.String(), "history")
+	x.PaginationHeader(w, u, total, page, itemsPerPage)
+	h.r.Writer().Write(w, r, entries)
+}
+
+// A list of credentials without their secrets.
+// swagger:model identityCredentialsMetadataList
+// nolint:deadcode,unused
+type identityCredentialsMetadataList []CredentialsMetadata
+
+// swagger:parameters adminListIdentityCredentials
+// nolint:deadcode,unused
+type adminListIdentityCredentials struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// Type
+	//
+	// Only return credentials of this type, e.g. `password`, `oidc`, `totp`, `lookup_secret`, or `webauthn`.
+	//
+	// in: query
+	Type string `json:"type"`
+}
+
+// swagger:route GET /identities/{id}/credentials v0alpha2 adminListIdentityCredentials
+//
+// List the Credentials of an Identity
+//
+// This endpoint lists the credentials of an identity ordered by type. Secrets such as password hashes, tokens,
+// TOTP keys, and lookup secrets are never included. Linked OpenID Connect providers and WebAuthn security keys are
+// listed as items which can be removed individually using `DELETE /identities/{id}/credentials/{type}`.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: identityCredentialsMetadataList
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) listCredentials(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	i, err := h.r.PrivilegedIdentityPool().GetIdentityConfidential(r.Context(), x.ParseUUID(ps.ByName("id")))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	filter := CredentialsType(r.URL.Query().Get("type"))
+	credentials := make([]CredentialsMetadata, 0, len(i.Credentials))
+	for t, c := range i.Credentials {
+		if filter != "" && t != filter {
+			continue
+		}
+		c.Type = t
+		credentials = append(credentials, *NewCredentialsMetadata(c))
+	}
+	sort.Slice(credentials, func(a, b int) bool {
+		return credentials[a].Type < credentials[b].Type
+	})
+
+	h.r.Writer().Write(w, r, credentials)
+}
+
+// A verifiable address including the ID of the identity it belongs to.
+// swagger:model adminVerifiableIdentityAddress
+type adminVerifiableIdentityAddress struct {
+	VerifiableAddress
+
+	// IdentityID is the ID of the identity the address belongs to.
+	//
+	// required: true
+	IdentityID uuid.UUID `json:"identity_id"`
+}
+
+// A list of verifiable addresses.
+// swagger:model adminVerifiableIdentityAddressList
+// nolint:deadcode,unused
+type adminVerifiableIdentityAddressList []adminVerifiableIdentityAddress
+
+// swagger:parameters adminListVerifiableAddresses
+// nolint:deadcode,unused
+type adminListVerifiableAddresses struct {
+	// Items per Page
+	//
+	// This is the number of items per page.
+	//
+	// required: false
+	// in: query
+	// default: 250
+	// min: 1
+	// max: 1000
+	PerPage int `json:"per_page"`
+
+	// Page Token
+	//
+	// The token of the page to return. Omit it to get the first page. The token of the next page is part
+	// of the `next` relation in the `Link` response header.
+	//
+	// required: false
+	// in: query
+	PageToken string `json:"page_token"`
+
+	// Status
+	//
+	// Only return addresses in this verification status, one of `pending`, `sent`, or `completed`.
+	//
+	// required: false
+	// in: query
+	Status string `json:"status"`
+}
+
+// swagger:route GET /verifiable-addresses v0alpha2 adminListVerifiableAddresses
+//
+// List Verifiable Addresses
+//
+// This endpoint lists the verifiable addresses of all identities ordered by descending ID, optionally narrowed
+// down to a verification status. Addresses are paginated using the `page_token` query parameter, the `Link`
+// response header contains the URL of the first page and, unless this is the last page, of the next page.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: adminVerifiableIdentityAddressList
+//       400: jsonError
+//       500: jsonError
+func (h *Handler) listVerifiableAddresses(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var filter ListVerifiableAddressesFilter
+	if status := VerifiableAddressStatus(r.URL.Query().Get("status")); status != "" {
+		if err := status.IsValid(); err != nil {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Invalid value `%s` for parameter `status`.", status).WithWrap(err)))
+			return
+		}
+		filter.Status = status
+	}
+
+	page, err := x.ParsePage(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	as, err := h.r.PrivilegedIdentityPool().ListVerifiableAddresses(r.Context(), filter, page)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	out := make([]adminVerifiableIdentityAddress, len(as))
+	var last uuid.UUID
+	for k, a := range as {
+		out[k] = adminVerifiableIdentityAddress{VerifiableAddress: a, IdentityID: a.IdentityID}
+		last = a.ID
+	}
+
+	u := urlx.AppendPaths(h.r.Config(r.Context()).SelfAdminURL(), RouteVerifiableAddresses)
+	u.RawQuery = r.URL.RawQuery
+	x.KeysetPaginationHeader(w, u, page, len(as), last)
+	h.r.Writer().Write(w, r, out)
+}
+
+// swagger:parameters adminUpdateVerifiableAddress
+// nolint:deadcode,unused
+type adminUpdateVerifiableAddress struct {
+	// ID is the verifiable address' ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// in: body
+	Body adminUpdateVerifiableAddressBody
+}
+
+// swagger:model adminUpdateVerifiableAddressBody
+type adminUpdateVerifiableAddressBody struct {
+	// Verified marks the address as verified or as not verified.
+	//
+	// required: true
+	Verified bool `json:"verified"`
+}
+
+// swagger:route PUT /verifiable-addresses/{id} v0alpha2 adminUpdateVerifiableAddress
+//
+// Mark a Verifiable Address as Verified or Not Verified
+//
+// This endpoint changes whether a verifiable address is verified without changing the identity's traits. Marking
+// an address as verified completes its verification, marking it as not verified resets it to `pending` so that
+// it can be verified again.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: adminVerifiableIdentityAddress
+//       400: jsonError
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) updateVerifiableAddress(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var body adminUpdateVerifiableAddressBody
+	if err := jsonx.NewStrictDecoder(r.Body).Decode(&body); err != nil {
+		h.r.Writer().WriteErrorCode(w, r, http.StatusBadRequest, errors.WithStack(err))
+		return
+	}
+
+	address, err := h.r.PrivilegedIdentityPool().GetVerifiableAddress(r.Context(), x.ParseUUID(ps.ByName("id")))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if body.Verified && !address.Verified {
+		verifiedAt := sqlxx.NullTime(time.Now().UTC())
+		address.VerifiedAt = &verifiedAt
+		address.Status = VerifiableAddressStatusCompleted
+	} else if !body.Verified {
+		address.VerifiedAt = nil
+		address.Status = VerifiableAddressStatusPending
+	}
+	address.Verified = body.Verified
+
+	if err := h.r.PrivilegedIdentityPool().UpdateVerifiableAddress(r.Context(), address); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, &adminVerifiableIdentityAddress{VerifiableAddress: *address, IdentityID: address.IdentityID})
+}
+
+// A recovery address including the ID of the identity it belongs to.
+// swagger:model adminRecoveryIdentityAddress
+type adminRecoveryIdentityAddress struct {
+	RecoveryAddress
+
+	// IdentityID is the ID of the identity the address belongs to.
+	//
+	// required: true
+	IdentityID uuid.UUID `json:"identity_id"`
+}
+
+// A list of recovery addresses.
+// swagger:model adminRecoveryIdentityAddressList
+// nolint:deadcode,unused
+type adminRecoveryIdentityAddressList []adminRecoveryIdentityAddress
+
+// swagger:parameters adminListRecoveryAddresses
+// nolint:deadcode,unused
+type adminListRecoveryAddresses struct {
+	// Items per Page
+	//
+	// This is the number of items per page.
+	//
+	// required: false
+	// in: query
+	// default: 250
+	// min: 1
+	// max: 1000
+	PerPage int `json:"per_page"`
+
+	// Page Token
+	//
+	// The token of the page to return. Omit it to get the first page. The token of the next page is part
+	// of the `next` relation in the `Link` response header.
+	//
+	// required: false
+	// in: query
+	PageToken string `json:"page_token"`
+}
+
+// swagger:route GET /recovery-addresses v0alpha2 adminListRecoveryAddresses
+//
+// List Recovery Addresses
+//
+// This endpoint lists the recovery addresses of all identities ordered by descending ID. Addresses are paginated
+// using the `page_token` query parameter, the `Link` response header contains the URL of the first page and,
+// unless this is the last page, of the next page.
+//
+// Learn how identities work in [Ory Kratos' User And Identity Model Documentation](https://www.ory.sh/docs/next/kratos/concepts/identity-user-model).
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: adminRecoveryIdentityAddressList
+//       400: jsonError
+//       500: jsonError
+func (h *Handler) listRecoveryAddresses(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	page, err := x.ParsePage(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	as, err := h.r.PrivilegedIdentityPool().ListRecoveryAddresses(r.Context(), page)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	out := make([]adminRecoveryIdentityAddress, len(as))
+	var last uuid.UUID
+	for k, a := range as {
+		out[k] = adminRecoveryIdentityAddress{RecoveryAddress: a, IdentityID: a.IdentityID}
+		last = a.ID
+	}
+
+	u := urlx.AppendPaths(h.r.Config(r.Context()).SelfAdminURL(), RouteRecoveryAddresses)
+	u.RawQuery = r.URL.RawQuery
+	x.KeysetPaginationHeader(w, u, page, len(as), last)
+	h.r.Writer().Write(w, r, out)
+}
@@ -0,0 +1,125 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/go-convenience/stringslice"
+	"github.com/ory/herodot"
+
+	"github.com/ory/kratos/hash"
+)
+
+// swagger:model adminIdentityImportCredentials
+type AdminIdentityImportCredentials struct {
+	// Password if set will import a password credential.
+	Password *AdminIdentityImportCredentialsPassword `json:"password"`
+
+	// OIDC if set will import an OIDC credential.
+	OIDC *AdminIdentityImportCredentialsOIDC `json:"oidc"`
+}
+
+// swagger:model adminIdentityImportCredentialsPassword
+type AdminIdentityImportCredentialsPassword struct {
+	// Configuration options for the import.
+	Config AdminIdentityImportCredentialsPasswordConfig `json:"config"`
+}
+
+// swagger:model adminIdentityImportCredentialsPasswordConfig
+type AdminIdentityImportCredentialsPasswordConfig struct {
+	// The hashed password in [PHC format](https://www.ory.sh/docs/kratos/concepts/credentials/username-email-password#hashed-password-format)
+	HashedPassword string `json:"hashed_password"`
+
+	// The password in plain text if no hash is available.
+	Password string `json:"password"`
+}
+
+// swagger:model adminIdentityImportCredentialsOidc
+type AdminIdentityImportCredentialsOIDC struct {
+	// Configuration options for the import.
+	Config AdminIdentityImportCredentialsOIDCConfig `json:"config"`
+}
+
+// swagger:model adminIdentityImportCredentialsOidcConfig
+type AdminIdentityImportCredentialsOIDCConfig struct {
+	// A list of OpenID Connect Providers
+	Providers []AdminIdentityImportCredentialsOIDCProvider `json:"providers"`
+}
+
+// swagger:model adminIdentityImportCredentialsOidcProvider
+type AdminIdentityImportCredentialsOIDCProvider struct {
+	// The subject (`sub`) of the OpenID Connect connection. Usually the `sub` field of the ID Token.
+	//
+	// required: true
+	Subject string `json:"subject"`
+
+	// The OpenID Connect provider to link the subject to. Usually something like `google` or `github`.
+	//
+	// required: true
+	Provider string `json:"provider"`
+}
+
+// importTo sets the credentials on the identity. The credentials' identifiers derived from the traits are
+// set by SchemaExtensionCredentials when the identity is validated.
+func (c *AdminIdentityImportCredentials) importTo(ctx context.Context, hasher hash.Hasher, i *Identity) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Password != nil {
+		if err := c.Password.importTo(ctx, hasher, i); err != nil {
+			return err
+		}
+	}
+
+	if c.OIDC != nil {
+		if err := c.OIDC.importTo(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *AdminIdentityImportCredentialsPassword) importTo(ctx context.Context, hasher hash.Hasher, i *Identity) error {
+	// Password policies are deliberately not enforced here: imported users must be able to sign in with the
+	// password they used before, even if it would not be accepted during registration.
+	hashed := []byte(c.Config.HashedPassword)
+	if len(c.Config.Password) > 0 {
+		if len(hashed) > 0 {
+			return errors.WithStack(herodot.ErrBadRequest.WithReason("Only one of `hashed_password` and `password` may be set when importing password credentials."))
+		}
+
+		var err error
+		hashed, err = hasher.Generate(ctx, []byte(c.Config.Password))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !(hash.IsBcryptHash(hashed) || hash.IsArgon2idHash(hashed) || hash.IsPbkdf2Hash(hashed)) {
+		return errors.WithStack(herodot.ErrBadRequest.WithReason("The imported password hash does not match any supported hash format. Supported formats are bcrypt, argon2id, and pbkdf2."))
+	}
+
+	return i.SetCredentialsWithConfig(CredentialsTypePassword, Credentials{Identifiers: []string{}}, CredentialsPassword{HashedPassword: string(hashed)})
+}
+
+func (c *AdminIdentityImportCredentialsOIDC) importTo(i *Identity) error {
+	if len(c.Config.Providers) == 0 {
+		return errors.WithStack(herodot.ErrBadRequest.WithReason("Importing OpenID Connect credentials requires at least one provider."))
+	}
+
+	identifiers := make([]string, 0, len(c.Config.Providers))
+	providers := make([]CredentialsOIDCProvider, 0, len(c.Config.Providers))
+	for _, p := range c.Config.Providers {
+		if p.Provider == "" || p.Subject == "" {
+			return errors.WithStack(herodot.ErrBadRequest.WithReason("Imported OpenID Connect credentials require fields `provider` and `subject`."))
+		}
+
+		identifiers = append(identifiers, OIDCUniqueID(p.Provider, p.Subject))
+		providers = append(providers, CredentialsOIDCProvider{Subject: p.Subject, Provider: p.Provider})
+	}
+
+	return i.SetCredentialsWithConfig(CredentialsTypeOIDC, Credentials{Identifiers: stringslice.Unique(identifiers)}, CredentialsOIDC{Providers: providers})
+}
@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,11 +19,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/ory/x/sqlxx"
 	"github.com/ory/x/urlx"
 
 	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/hash"
 	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/internal"
 	"github.com/ory/kratos/internal/testhelpers"
@@ -43,6 +47,8 @@ func TestHandler(t *testing.T) {
 	testhelpers.SetIdentitySchemas(t, conf, map[string]string{
 		"customer": "file://./stub/handler/customer.schema.json",
 		"employee": "file://./stub/handler/employee.schema.json",
+		"legacy":   "file://./stub/handler/employee.schema.json",
+		"unique":   "file://./stub/unique.schema.json",
 	})
 	conf.MustSet(config.ViperKeyPublicBaseURL, mockServerURL.String())
 
@@ -359,6 +365,164 @@ func TestHandler(t *testing.T) {
 				})
 			}
 		})
+
+		t.Run("case=should soft delete a user and restore it", func(t *testing.T) {
+			conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, true)
+			t.Cleanup(func() {
+				conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, false)
+			})
+
+			for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+				t.Run("endpoint="+name, func(t *testing.T) {
+					id := send(t, ts, "POST", "/identities", http.StatusCreated, json.RawMessage(`{"traits": {"bar":"baz"}}`)).Get("id").String()
+					remove(t, ts, "/identities/"+id, http.StatusNoContent)
+					_ = get(t, ts, "/identities/"+id, http.StatusNotFound)
+					remove(t, ts, "/identities/"+id, http.StatusNotFound)
+
+					res := send(t, ts, "POST", "/identities/"+id+"/restore", http.StatusOK, nil)
+					assert.EqualValues(t, id, res.Get("id").String(), "%s", res.Raw)
+					assert.EqualValues(t, "baz", res.Get("traits.bar").String(), "%s", res.Raw)
+
+					_ = get(t, ts, "/identities/"+id, http.StatusOK)
+					_ = send(t, ts, "POST", "/identities/"+id+"/restore", http.StatusNotFound, nil)
+					remove(t, ts, "/identities/"+id, http.StatusNoContent)
+				})
+			}
+		})
+
+		t.Run("case=should not restore an identity which does not exist", func(t *testing.T) {
+			for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+				t.Run("endpoint="+name, func(t *testing.T) {
+					_ = send(t, ts, "POST", "/identities/"+x.NewUUID().String()+"/restore", http.StatusNotFound, nil)
+				})
+			}
+		})
+
+		t.Run("case=should list the credentials of an identity without secrets", func(t *testing.T) {
+			i := identity.NewIdentity("")
+			subject := i.ID.String()
+			i.SetCredentials(identity.CredentialsTypeOIDC, identity.Credentials{
+				Identifiers: []string{"github:" + subject},
+				Config:      sqlxx.JSONRawMessage(`{"providers":[{"provider":"github","subject":"` + subject + `","initial_access_token":"secret"}]}`),
+			})
+			i.SetCredentials(identity.CredentialsTypePassword, identity.Credentials{
+				Identifiers: []string{subject + "@ory.sh"},
+				Config:      sqlxx.JSONRawMessage(`{"hashed_password":"secret"}`),
+			})
+			require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(context.Background(), i))
+
+			for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+				t.Run("endpoint="+name, func(t *testing.T) {
+					res := get(t, ts, "/identities/"+i.ID.String()+"/credentials", http.StatusOK)
+					assert.NotContains(t, res.Raw, "secret")
+					require.Len(t, res.Array(), 2, "%s", res.Raw)
+					assert.EqualValues(t, "oidc", res.Get("0.type").String(), "%s", res.Raw)
+					assert.EqualValues(t, "github:"+subject, res.Get("0.items.0.id").String(), "%s", res.Raw)
+					assert.EqualValues(t, "github", res.Get("0.items.0.provider").String(), "%s", res.Raw)
+					assert.EqualValues(t, "password", res.Get("1.type").String(), "%s", res.Raw)
+					assert.EqualValues(t, subject+"@ory.sh", res.Get("1.identifiers.0").String(), "%s", res.Raw)
+
+					res = get(t, ts, "/identities/"+i.ID.String()+"/credentials?type=password", http.StatusOK)
+					require.Len(t, res.Array(), 1, "%s", res.Raw)
+					assert.EqualValues(t, "password", res.Get("0.type").String(), "%s", res.Raw)
+
+					_ = get(t, ts, "/identities/"+x.NewUUID().String()+"/credentials", http.StatusNotFound)
+				})
+			}
+		})
+
+		t.Run("case=should list the change history of an identity", func(t *testing.T) {
+			conf.MustSet(config.ViperKeyIdentityHistoryEnabled, true)
+			t.Cleanup(func() {
+				conf.MustSet(config.ViperKeyIdentityHistoryEnabled, false)
+			})
+
+			for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+				t.Run("endpoint="+name, func(t *testing.T) {
+					id := send(t, ts, "POST", "/identities", http.StatusCreated, json.RawMessage(`{"traits": {"bar":"baz"}}`)).Get("id").String()
+					_ = send(t, ts, "PUT", "/identities/"+id, http.StatusOK, json.RawMessage(`{"traits": {"bar":"qux"}}`))
+
+					res := get(t, ts, "/identities/"+id+"/history", http.StatusOK)
+					require.Len(t, res.Array(), 1, "%s", res.Raw)
+					assert.EqualValues(t, id, res.Get("0.identity_id").String(), "%s", res.Raw)
+					assert.EqualValues(t, identity.HistoryCauseAdminAPI, res.Get("0.cause").String(), "%s", res.Raw)
+					assert.EqualValues(t, "traits", res.Get("0.changes.0.field").String(), "%s", res.Raw)
+					assert.EqualValues(t, "baz", res.Get("0.changes.0.old.bar").String(), "%s", res.Raw)
+					assert.EqualValues(t, "qux", res.Get("0.changes.0.new.bar").String(), "%s", res.Raw)
+
+					_ = get(t, ts, "/identities/"+x.NewUUID().String()+"/history", http.StatusNotFound)
+				})
+			}
+		})
+	})
+
+	t.Run("case=should migrate identities to another schema", func(t *testing.T) {
+		transform := `local t = std.extVar('identity').traits; {identity: {traits: {[k]: t[k] for k in std.objectFields(t) if k != 'department'} + {address: t.department}}}`
+
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				create := func(traits string) string {
+					return send(t, ts, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+						SchemaID: "legacy",
+						Traits:   []byte(traits),
+					}).Get("id").String()
+				}
+				valid := []string{
+					create(`{"email":"` + x.NewUUID().String() + `@ory.sh","department":"sales"}`),
+					create(`{"email":"` + x.NewUUID().String() + `@ory.sh","department":"legal"}`),
+				}
+				invalid := create(`{"email":"` + x.NewUUID().String() + `@ory.sh","department":"it","unknown":"foo"}`)
+				t.Cleanup(func() {
+					remove(t, ts, "/identities/"+invalid, http.StatusNoContent)
+				})
+
+				t.Run("case=dry run", func(t *testing.T) {
+					res := send(t, ts, "POST", "/schemas/legacy/migrate", http.StatusOK, &identity.AdminMigrateIdentitySchemaBody{
+						ToSchemaID: "customer",
+						Transform:  transform,
+						DryRun:     true,
+					})
+					assert.True(t, res.Get("dry_run").Bool(), "%s", res.Raw)
+					assert.EqualValues(t, 3, res.Get("total").Int(), "%s", res.Raw)
+					assert.EqualValues(t, 2, res.Get("migrated").Int(), "%s", res.Raw)
+					assert.EqualValues(t, invalid, res.Get("failures.0.identity_id").String(), "%s", res.Raw)
+					assert.Contains(t, res.Get("failures.0.error.reason").String(), "unknown", "%s", res.Raw)
+
+					for _, id := range valid {
+						assert.EqualValues(t, "legacy", get(t, ts, "/identities/"+id, http.StatusOK).Get("schema_id").String())
+					}
+				})
+
+				t.Run("case=migrate", func(t *testing.T) {
+					res := send(t, ts, "POST", "/schemas/legacy/migrate", http.StatusOK, &identity.AdminMigrateIdentitySchemaBody{
+						ToSchemaID: "customer",
+						Transform:  transform,
+					})
+					assert.False(t, res.Get("dry_run").Bool(), "%s", res.Raw)
+					assert.EqualValues(t, 3, res.Get("total").Int(), "%s", res.Raw)
+					assert.EqualValues(t, 2, res.Get("migrated").Int(), "%s", res.Raw)
+					assert.Len(t, res.Get("failures").Array(), 1, "%s", res.Raw)
+
+					for k, id := range valid {
+						actual := get(t, ts, "/identities/"+id, http.StatusOK)
+						assert.EqualValues(t, "customer", actual.Get("schema_id").String(), "%s", actual.Raw)
+						assert.EqualValues(t, []string{"sales", "legal"}[k], actual.Get("traits.address").String(), "%s", actual.Raw)
+						assert.False(t, actual.Get("traits.department").Exists(), "%s", actual.Raw)
+					}
+					assert.EqualValues(t, "legacy", get(t, ts, "/identities/"+invalid, http.StatusOK).Get("schema_id").String())
+				})
+
+				t.Run("case=fails with unknown schema", func(t *testing.T) {
+					res := send(t, ts, "POST", "/schemas/legacy/migrate", http.StatusBadRequest, &identity.AdminMigrateIdentitySchemaBody{ToSchemaID: "does-not-exist"})
+					assert.Contains(t, res.Get("error.reason").String(), "does-not-exist", "%s", res.Raw)
+				})
+
+				t.Run("case=fails with invalid transformation", func(t *testing.T) {
+					res := send(t, ts, "POST", "/schemas/legacy/migrate", http.StatusBadRequest, &identity.AdminMigrateIdentitySchemaBody{ToSchemaID: "customer", Transform: "{"})
+					assert.Contains(t, res.Get("error.reason").String(), "Jsonnet", "%s", res.Raw)
+				})
+			})
+		}
 	})
 
 	t.Run("case=should return entity with credentials metadata", func(t *testing.T) {
@@ -460,6 +624,82 @@ func TestHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("case=should create an identity with imported credentials", func(t *testing.T) {
+		hashed, err := bcrypt.GenerateFromPassword([]byte("legacy-password"), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		for name, creds := range map[string]*identity.AdminIdentityImportCredentials{
+			"hashed": {Password: &identity.AdminIdentityImportCredentialsPassword{
+				Config: identity.AdminIdentityImportCredentialsPasswordConfig{HashedPassword: string(hashed)}}},
+			"cleartext": {Password: &identity.AdminIdentityImportCredentialsPassword{
+				Config: identity.AdminIdentityImportCredentialsPasswordConfig{Password: "legacy-password"}}},
+		} {
+			t.Run("password="+name, func(t *testing.T) {
+				email := x.NewUUID().String() + "@ory.sh"
+				res := send(t, adminTS, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+					SchemaID:    "default",
+					Traits:      []byte(`{"email":"` + email + `"}`),
+					Credentials: creds,
+				})
+
+				actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), x.ParseUUID(res.Get("id").String()))
+				require.NoError(t, err)
+
+				var cc identity.CredentialsPassword
+				c, err := actual.ParseCredentials(identity.CredentialsTypePassword, &cc)
+				require.NoError(t, err)
+				assert.EqualValues(t, []string{email}, c.Identifiers)
+				assert.NoError(t, hash.Compare(context.Background(), []byte("legacy-password"), []byte(cc.HashedPassword)))
+			})
+		}
+
+		t.Run("oidc", func(t *testing.T) {
+			subject := x.NewUUID().String()
+			res := send(t, adminTS, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+				SchemaID: "default",
+				Traits:   []byte(`{"email":"` + x.NewUUID().String() + `@ory.sh"}`),
+				Credentials: &identity.AdminIdentityImportCredentials{OIDC: &identity.AdminIdentityImportCredentialsOIDC{
+					Config: identity.AdminIdentityImportCredentialsOIDCConfig{Providers: []identity.AdminIdentityImportCredentialsOIDCProvider{
+						{Provider: "google", Subject: subject},
+						{Provider: "github", Subject: subject},
+					}}}},
+			})
+
+			actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), x.ParseUUID(res.Get("id").String()))
+			require.NoError(t, err)
+
+			var cc identity.CredentialsOIDC
+			c, err := actual.ParseCredentials(identity.CredentialsTypeOIDC, &cc)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"google:" + subject, "github:" + subject}, c.Identifiers)
+			require.Len(t, cc.Providers, 2)
+			assert.Equal(t, "google", cc.Providers[0].Provider)
+			assert.Equal(t, subject, cc.Providers[0].Subject)
+		})
+	})
+
+	t.Run("case=should fail to create an identity with invalid imported credentials", func(t *testing.T) {
+		for name, creds := range map[string]*identity.AdminIdentityImportCredentials{
+			"unknown hash": {Password: &identity.AdminIdentityImportCredentialsPassword{
+				Config: identity.AdminIdentityImportCredentialsPasswordConfig{HashedPassword: "$md5$not-supported"}}},
+			"empty password": {Password: &identity.AdminIdentityImportCredentialsPassword{}},
+			"hash and cleartext": {Password: &identity.AdminIdentityImportCredentialsPassword{
+				Config: identity.AdminIdentityImportCredentialsPasswordConfig{HashedPassword: "$2a$04$foo", Password: "bar"}}},
+			"oidc without providers": {OIDC: &identity.AdminIdentityImportCredentialsOIDC{}},
+			"oidc without subject": {OIDC: &identity.AdminIdentityImportCredentialsOIDC{
+				Config: identity.AdminIdentityImportCredentialsOIDCConfig{Providers: []identity.AdminIdentityImportCredentialsOIDCProvider{{Provider: "google"}}}}},
+		} {
+			t.Run("case="+name, func(t *testing.T) {
+				res := send(t, adminTS, "POST", "/identities", http.StatusBadRequest, &identity.AdminCreateIdentityBody{
+					SchemaID:    "default",
+					Traits:      []byte(`{"email":"` + x.NewUUID().String() + `@ory.sh"}`),
+					Credentials: creds,
+				})
+				assert.EqualValues(t, http.StatusBadRequest, res.Get("error.code").Int(), "%s", res.Raw)
+			})
+		}
+	})
+
 	t.Run("case=should create and sync metadata and update privileged traits", func(t *testing.T) {
 		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
 			t.Run("endpoint="+name, func(t *testing.T) {
@@ -487,6 +727,82 @@ func TestHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("case=should set, look up, and change the external id", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				externalID := x.NewUUID().String()
+				res := send(t, ts, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+					Traits:     []byte(`{"bar":"baz"}`),
+					ExternalID: externalID,
+				})
+				assert.EqualValues(t, externalID, res.Get("external_id").String(), "%s", res.Raw)
+				id := res.Get("id").String()
+
+				res = get(t, ts, "/identities/by/external_id/"+externalID, http.StatusOK)
+				assert.EqualValues(t, id, res.Get("id").String(), "%s", res.Raw)
+				get(t, ts, "/identities/foo/external_id/"+externalID, http.StatusNotFound)
+				get(t, ts, "/identities/by/external_id/"+x.NewUUID().String(), http.StatusNotFound)
+
+				send(t, ts, "POST", "/identities", http.StatusConflict, &identity.AdminCreateIdentityBody{
+					Traits:     []byte(`{"bar":"baz"}`),
+					ExternalID: externalID,
+				})
+				send(t, ts, "POST", "/identities", http.StatusBadRequest, &identity.AdminCreateIdentityBody{
+					Traits:     []byte(`{"bar":"baz"}`),
+					ExternalID: strings.Repeat("a", 256),
+				})
+
+				updatedID := x.NewUUID().String()
+				res = send(t, ts, "PUT", "/identities/"+id, http.StatusOK, &identity.AdminUpdateIdentityBody{
+					Traits:     []byte(`{"bar":"baz"}`),
+					ExternalID: updatedID,
+				})
+				assert.EqualValues(t, updatedID, res.Get("external_id").String(), "%s", res.Raw)
+				get(t, ts, "/identities/by/external_id/"+externalID, http.StatusNotFound)
+
+				patchedID := x.NewUUID().String()
+				res = send(t, ts, "PATCH", "/identities/"+id, http.StatusOK, identity.JSONPatchDocument{
+					{Op: "replace", Path: "/external_id", Value: patchedID},
+				})
+				assert.EqualValues(t, patchedID, res.Get("external_id").String(), "%s", res.Raw)
+				res = get(t, ts, "/identities/by/external_id/"+patchedID, http.StatusOK)
+				assert.EqualValues(t, id, res.Get("id").String(), "%s", res.Raw)
+
+				res = send(t, ts, "PUT", "/identities/"+id, http.StatusOK, &identity.AdminUpdateIdentityBody{
+					Traits: []byte(`{"bar":"baz"}`),
+				})
+				assert.False(t, res.Get("external_id").Exists(), "%s", res.Raw)
+				get(t, ts, "/identities/by/external_id/"+patchedID, http.StatusNotFound)
+			})
+		}
+	})
+
+	t.Run("case=should not create or update an identity with a duplicate unique trait", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				username := x.NewUUID().String()
+				send(t, ts, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+					SchemaID: "unique",
+					Traits:   []byte(`{"username":"` + username + `"}`),
+				})
+
+				res := send(t, ts, "POST", "/identities", http.StatusConflict, &identity.AdminCreateIdentityBody{
+					SchemaID: "unique",
+					Traits:   []byte(`{"username":"` + username + `"}`),
+				})
+				assert.Contains(t, res.Get("error.reason").String(), username, "%s", res.Raw)
+
+				res = send(t, ts, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+					SchemaID: "unique",
+					Traits:   []byte(`{"username":"` + x.NewUUID().String() + `"}`),
+				})
+				send(t, ts, "PUT", "/identities/"+res.Get("id").String(), http.StatusConflict, &identity.AdminUpdateIdentityBody{
+					Traits: []byte(`{"username":"` + username + `"}`),
+				})
+			})
+		}
+	})
+
 	t.Run("case=should update the schema id and fail because traits are invalid", func(t *testing.T) {
 		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
 			t.Run("endpoint="+name, func(t *testing.T) {
@@ -575,6 +891,357 @@ func TestHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("case=should list identities matching the filter", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				department := x.NewUUID().String()
+				var cr identity.AdminCreateIdentityBody
+				cr.SchemaID = "employee"
+				cr.Traits = []byte(`{"department": "` + department + `"}`)
+				created := send(t, ts, "POST", "/identities", http.StatusCreated, &cr)
+
+				res := get(t, ts, "/identities?schema_id=employee&state=active&traits.department="+department, http.StatusOK)
+				require.Len(t, res.Array(), 1, "%s", res.Raw)
+				assert.EqualValues(t, created.Get("id").String(), res.Get("0.id").String(), "%s", res.Raw)
+
+				res = get(t, ts, "/identities?schema_id=customer&traits.department="+department, http.StatusOK)
+				assert.Len(t, res.Array(), 0, "%s", res.Raw)
+			})
+		}
+	})
+
+	t.Run("case=should paginate identities using page tokens", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				department := x.NewUUID().String()
+				var created []string
+				for k := 0; k < 3; k++ {
+					var cr identity.AdminCreateIdentityBody
+					cr.SchemaID = "employee"
+					cr.Traits = []byte(`{"department": "` + department + `"}`)
+					created = append(created, send(t, ts, "POST", "/identities", http.StatusCreated, &cr).Get("id").String())
+				}
+
+				nextToken := regexp.MustCompile(`page_token=([^&>]+)[^>]*>; rel="next"`)
+				var listed []string
+				query := "per_page=2&traits.department=" + department
+				for k := 0; k < 2; k++ {
+					res, err := ts.Client().Get(ts.URL + "/identities?" + query)
+					require.NoError(t, err)
+					body, err := ioutil.ReadAll(res.Body)
+					require.NoError(t, err)
+					require.NoError(t, res.Body.Close())
+					require.EqualValues(t, http.StatusOK, res.StatusCode, "%s", body)
+					assert.Empty(t, res.Header.Get("X-Total-Count"))
+
+					for _, id := range gjson.GetBytes(body, "#.id").Array() {
+						listed = append(listed, id.String())
+					}
+
+					match := nextToken.FindStringSubmatch(res.Header.Get("Link"))
+					if k == 0 {
+						require.Len(t, match, 2, "%s", res.Header.Get("Link"))
+						query += "&page_token=" + match[1]
+					} else {
+						assert.Empty(t, match, "%s", res.Header.Get("Link"))
+					}
+				}
+				assert.ElementsMatch(t, created, listed)
+
+				res := get(t, ts, "/identities?page_token=not-a-token", http.StatusBadRequest)
+				assert.EqualValues(t, http.StatusBadRequest, res.Get("error.code").Int(), "%s", res.Raw)
+			})
+		}
+	})
+
+	t.Run("case=should fail to list identities with an invalid filter", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				for _, query := range []string{
+					"state=not-a-state",
+					"created_after=yesterday",
+					"created_before=2021-13-01T00:00:00Z",
+					"traits.a%3Bdrop=1",
+					"traits.0foo=1",
+					"traits.email=a&traits.email=b",
+				} {
+					t.Run("query="+query, func(t *testing.T) {
+						res := get(t, ts, "/identities?"+query, http.StatusBadRequest)
+						assert.EqualValues(t, http.StatusBadRequest, res.Get("error.code").Int(), "%s", res.Raw)
+					})
+				}
+			})
+		}
+	})
+
+	t.Run("case=should create, update, and delete identities in a batch", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				email := x.NewUUID().String() + "@ory.sh"
+				toUpdate := send(t, ts, "POST", "/identities", http.StatusCreated, json.RawMessage(`{"schema_id":"employee","traits":{"department":"a"}}`)).Get("id").String()
+				toDelete := send(t, ts, "POST", "/identities", http.StatusCreated, json.RawMessage(`{"schema_id":"employee","traits":{"department":"a"}}`)).Get("id").String()
+
+				res := send(t, ts, "PATCH", "/identities", http.StatusOK, json.RawMessage(`{"identities":[
+	{"action":"create","create":{"schema_id":"employee","traits":{"email":"`+email+`"}}},
+	{"action":"create","create":{"schema_id":"employee","traits":{"email":"`+email+`"}}},
+	{"action":"create","create":{"schema_id":"employee","traits":{"department":1}}},
+	{"action":"update","id":"`+toUpdate+`","update":{"traits":{"department":"b"},"state":"inactive"}},
+	{"action":"delete","id":"`+toDelete+`"},
+	{"action":"update","id":"`+x.NewUUID().String()+`","update":{"traits":{}}},
+	{"action":"create","id":"`+x.NewUUID().String()+`","create":{"schema_id":"employee","traits":{}}},
+	{"action":"unknown"}
+]}`))
+				results := res.Get("identities").Array()
+				require.Len(t, results, 8, "%s", res.Raw)
+
+				created := results[0].Get("id").String()
+				assert.EqualValues(t, email, results[0].Get("identity.traits.email").String(), "%s", res.Raw)
+				assert.EqualValues(t, "create", results[0].Get("action").String(), "%s", res.Raw)
+				assert.False(t, results[0].Get("error").Exists(), "%s", res.Raw)
+				get(t, adminTS, "/identities/"+created, http.StatusOK)
+
+				assert.EqualValues(t, http.StatusConflict, results[1].Get("error.code").Int(), "%s", res.Raw)
+				assert.False(t, results[1].Get("id").Exists(), "%s", res.Raw)
+				assert.EqualValues(t, http.StatusBadRequest, results[2].Get("error.code").Int(), "%s", res.Raw)
+
+				assert.EqualValues(t, toUpdate, results[3].Get("id").String(), "%s", res.Raw)
+				updated := get(t, adminTS, "/identities/"+toUpdate, http.StatusOK)
+				assert.EqualValues(t, "b", updated.Get("traits.department").String(), "%s", updated.Raw)
+				assert.EqualValues(t, identity.StateInactive, updated.Get("state").String(), "%s", updated.Raw)
+
+				assert.EqualValues(t, toDelete, results[4].Get("id").String(), "%s", res.Raw)
+				assert.False(t, results[4].Get("identity").Exists(), "%s", res.Raw)
+				get(t, adminTS, "/identities/"+toDelete, http.StatusNotFound)
+
+				assert.EqualValues(t, http.StatusNotFound, results[5].Get("error.code").Int(), "%s", res.Raw)
+				assert.EqualValues(t, http.StatusBadRequest, results[6].Get("error.code").Int(), "%s", res.Raw)
+				assert.EqualValues(t, http.StatusBadRequest, results[7].Get("error.code").Int(), "%s", res.Raw)
+			})
+		}
+	})
+
+	t.Run("case=should create identities in a batch spanning several transactions", func(t *testing.T) {
+		department := x.NewUUID().String()
+		patches := make([]*identity.BatchIdentityPatch, 250)
+		for k := range patches {
+			patches[k] = &identity.BatchIdentityPatch{
+				Action: identity.BatchIdentityPatchActionCreate,
+				Create: &identity.AdminCreateIdentityBody{SchemaID: "employee", Traits: json.RawMessage(`{"department":"` + department + `"}`)},
+			}
+		}
+
+		res := send(t, adminTS, "PATCH", "/identities", http.StatusOK, identity.AdminBatchPatchIdentitiesBody{Identities: patches})
+		for k, result := range res.Get("identities").Array() {
+			assert.False(t, result.Get("error").Exists(), "%d: %s", k, result.Raw)
+		}
+
+		listed := get(t, adminTS, "/identities?per_page=500&traits.department="+department, http.StatusOK)
+		assert.Len(t, listed.Array(), len(patches))
+	})
+
+	t.Run("case=should fail to patch identities with an invalid batch", func(t *testing.T) {
+		patches := make([]*identity.BatchIdentityPatch, identity.BatchPatchIdentitiesMaxItems+1)
+		for k := range patches {
+			patches[k] = &identity.BatchIdentityPatch{Action: identity.BatchIdentityPatchActionDelete, ID: x.NewUUID()}
+		}
+
+		for _, body := range []interface{}{
+			identity.AdminBatchPatchIdentitiesBody{Identities: patches},
+			json.RawMessage(`{"identities":[null]}`),
+			json.RawMessage(`{"identities":[],"foo":"bar"}`),
+		} {
+			res := send(t, adminTS, "PATCH", "/identities", http.StatusBadRequest, body)
+			assert.EqualValues(t, http.StatusBadRequest, res.Get("error.code").Int(), "%s", res.Raw)
+		}
+	})
+
+	t.Run("case=should patch an identity", func(t *testing.T) {
+		i := identity.NewIdentity("employee")
+		i.Traits = identity.Traits(`{"email":"` + x.NewUUID().String() + `@ory.sh","department":"engineering"}`)
+		require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(context.Background(), i))
+
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				department := x.NewUUID().String()
+				res := send(t, ts, "PATCH", "/identities/"+i.ID.String(), http.StatusOK, identity.JSONPatchDocument{
+					{Op: "replace", Path: "/traits/department", Value: department},
+					{Op: "replace", Path: "/state", Value: identity.StateInactive},
+				})
+				assert.EqualValues(t, department, res.Get("traits.department").String(), "%s", res.Raw)
+				assert.EqualValues(t, identity.StateInactive, res.Get("state").String(), "%s", res.Raw)
+
+				actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+				require.NoError(t, err)
+				assert.EqualValues(t, department, gjson.GetBytes(actual.Traits, "department").String())
+				assert.EqualValues(t, identity.StateInactive, actual.State)
+				assert.NotEqual(t, i.StateChangedAt, actual.StateChangedAt)
+
+				res = send(t, ts, "PATCH", "/identities/"+i.ID.String(), http.StatusOK, identity.JSONPatchDocument{
+					{Op: "replace", Path: "/state", Value: identity.StateActive},
+				})
+				assert.EqualValues(t, identity.StateActive, res.Get("state").String(), "%s", res.Raw)
+			})
+		}
+	})
+
+	t.Run("case=should not patch an identity if a test operation fails", func(t *testing.T) {
+		i := identity.NewIdentity("employee")
+		i.Traits = identity.Traits(`{"email":"` + x.NewUUID().String() + `@ory.sh","department":"engineering"}`)
+		require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(context.Background(), i))
+
+		res := send(t, adminTS, "PATCH", "/identities/"+i.ID.String(), http.StatusConflict, identity.JSONPatchDocument{
+			{Op: "test", Path: "/traits/department", Value: "sales"},
+			{Op: "replace", Path: "/traits/department", Value: "marketing"},
+		})
+		assert.EqualValues(t, http.StatusConflict, res.Get("error.code").Int(), "%s", res.Raw)
+
+		actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+		require.NoError(t, err)
+		assert.EqualValues(t, "engineering", gjson.GetBytes(actual.Traits, "department").String())
+	})
+
+	t.Run("case=should fail to patch an identity with an invalid patch", func(t *testing.T) {
+		i := identity.NewIdentity("employee")
+		i.Traits = identity.Traits(`{"email":"` + x.NewUUID().String() + `@ory.sh"}`)
+		require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(context.Background(), i))
+
+		for name, patch := range map[string]interface{}{
+			"not a patch":       json.RawMessage(`{"op":"replace"}`),
+			"unknown operation": identity.JSONPatchDocument{{Op: "foo", Path: "/traits/email"}},
+			"missing path":      identity.JSONPatchDocument{{Op: "remove", Path: "/traits/does-not-exist"}},
+			"id":                identity.JSONPatchDocument{{Op: "replace", Path: "/id", Value: x.NewUUID().String()}},
+			"credentials":       identity.JSONPatchDocument{{Op: "add", Path: "/credentials", Value: map[string]interface{}{}}},
+			"invalid state":     identity.JSONPatchDocument{{Op: "replace", Path: "/state", Value: "foo"}},
+			"invalid traits":    identity.JSONPatchDocument{{Op: "replace", Path: "/traits/email", Value: 1234}},
+			"removed traits":    identity.JSONPatchDocument{{Op: "remove", Path: "/traits"}},
+		} {
+			t.Run("case="+name, func(t *testing.T) {
+				res := send(t, adminTS, "PATCH", "/identities/"+i.ID.String(), http.StatusBadRequest, patch)
+				assert.EqualValues(t, http.StatusBadRequest, res.Get("error.code").Int(), "%s", res.Raw)
+			})
+		}
+
+		actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(i.Traits), string(actual.Traits))
+	})
+
+	t.Run("case=should return 404 when patching an identity that does not exist", func(t *testing.T) {
+		_ = send(t, adminTS, "PATCH", "/identities/"+x.NewUUID().String(), http.StatusNotFound, identity.JSONPatchDocument{
+			{Op: "replace", Path: "/state", Value: identity.StateInactive},
+		})
+	})
+
+	t.Run("case=should create, update, and patch public and admin metadata", func(t *testing.T) {
+		res := send(t, adminTS, "POST", "/identities", http.StatusCreated, &identity.AdminCreateIdentityBody{
+			SchemaID:       "employee",
+			Traits:         []byte(`{"email":"` + x.NewUUID().String() + `@ory.sh"}`),
+			MetadataPublic: []byte(`{"plan":"free"}`),
+			MetadataAdmin:  []byte(`{"support":"basic"}`),
+		})
+		assert.JSONEq(t, `{"plan":"free"}`, res.Get("metadata_public").Raw, "%s", res.Raw)
+		assert.JSONEq(t, `{"support":"basic"}`, res.Get("metadata_admin").Raw, "%s", res.Raw)
+		id := res.Get("id").String()
+
+		res = get(t, adminTS, "/identities/"+id, http.StatusOK)
+		assert.JSONEq(t, `{"plan":"free"}`, res.Get("metadata_public").Raw, "%s", res.Raw)
+		assert.JSONEq(t, `{"support":"basic"}`, res.Get("metadata_admin").Raw, "%s", res.Raw)
+
+		res = get(t, adminTS, "/identities?per_page=1000", http.StatusOK)
+		assert.JSONEq(t, `{"support":"basic"}`, res.Get(`#(id=="`+id+`").metadata_admin`).Raw, "%s", res.Raw)
+
+		res = send(t, adminTS, "PATCH", "/identities/"+id, http.StatusOK, identity.JSONPatchDocument{
+			{Op: "replace", Path: "/metadata_public/plan", Value: "pro"},
+			{Op: "add", Path: "/metadata_admin/billing", Value: "yearly"},
+		})
+		assert.JSONEq(t, `{"plan":"pro"}`, res.Get("metadata_public").Raw, "%s", res.Raw)
+		assert.JSONEq(t, `{"support":"basic","billing":"yearly"}`, res.Get("metadata_admin").Raw, "%s", res.Raw)
+
+		res = send(t, adminTS, "PUT", "/identities/"+id, http.StatusOK, &identity.AdminUpdateIdentityBody{
+			State:          identity.StateActive,
+			Traits:         []byte(res.Get("traits").Raw),
+			MetadataPublic: []byte(`{"plan":"enterprise"}`),
+		})
+		assert.JSONEq(t, `{"plan":"enterprise"}`, res.Get("metadata_public").Raw, "%s", res.Raw)
+		assert.False(t, res.Get("metadata_admin").Exists(), "%s", res.Raw)
+
+		res = get(t, adminTS, "/identities/"+id, http.StatusOK)
+		assert.JSONEq(t, `{"plan":"enterprise"}`, res.Get("metadata_public").Raw, "%s", res.Raw)
+		assert.Nil(t, res.Get("metadata_admin").Value(), "%s", res.Raw)
+	})
+
+	t.Run("case=should only update an identity if the If-Match header matches its ETag", func(t *testing.T) {
+		i := identity.NewIdentity("employee")
+		i.Traits = identity.Traits(`{"email":"` + x.NewUUID().String() + `@ory.sh","department":"engineering"}`)
+		require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(context.Background(), i))
+
+		var do = func(t *testing.T, method, ifMatch string, expectCode int, send interface{}) *http.Response {
+			var b bytes.Buffer
+			if send != nil {
+				require.NoError(t, json.NewEncoder(&b).Encode(send))
+			}
+			req, err := http.NewRequest(method, adminTS.URL+"/identities/"+i.ID.String(), &b)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if ifMatch != "" {
+				req.Header.Set("If-Match", ifMatch)
+			}
+			res, err := adminTS.Client().Do(req)
+			require.NoError(t, err)
+			body, err := ioutil.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+			require.EqualValues(t, expectCode, res.StatusCode, "%s", body)
+			return res
+		}
+
+		etag := do(t, "GET", "", http.StatusOK, nil).Header.Get("ETag")
+		require.NotEmpty(t, etag)
+
+		update := func(department string) *identity.AdminUpdateIdentityBody {
+			return &identity.AdminUpdateIdentityBody{
+				State:  identity.StateActive,
+				Traits: []byte(`{"email":"` + gjson.GetBytes(i.Traits, "email").String() + `","department":"` + department + `"}`),
+			}
+		}
+
+		t.Run("case=rejects a mismatching ETag", func(t *testing.T) {
+			do(t, "PUT", `"1234"`, http.StatusPreconditionFailed, update("sales"))
+			do(t, "PUT", `"not-a-version"`, http.StatusPreconditionFailed, update("sales"))
+			do(t, "PATCH", `"1234"`, http.StatusPreconditionFailed, identity.JSONPatchDocument{
+				{Op: "replace", Path: "/traits/department", Value: "sales"},
+			})
+
+			actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+			require.NoError(t, err)
+			assert.EqualValues(t, "engineering", gjson.GetBytes(actual.Traits, "department").String())
+		})
+
+		t.Run("case=updates with a matching ETag", func(t *testing.T) {
+			res := do(t, "PUT", etag, http.StatusOK, update("sales"))
+			assert.NotEqual(t, etag, res.Header.Get("ETag"))
+			assert.Equal(t, res.Header.Get("ETag"), do(t, "GET", "", http.StatusOK, nil).Header.Get("ETag"))
+
+			// The previous ETag is now stale.
+			do(t, "PATCH", etag, http.StatusPreconditionFailed, identity.JSONPatchDocument{
+				{Op: "replace", Path: "/traits/department", Value: "marketing"},
+			})
+
+			etag = res.Header.Get("ETag")
+			res = do(t, "PATCH", etag, http.StatusOK, identity.JSONPatchDocument{
+				{Op: "replace", Path: "/traits/department", Value: "marketing"},
+			})
+			assert.NotEqual(t, etag, res.Header.Get("ETag"))
+		})
+
+		t.Run("case=updates with a wildcard or without If-Match", func(t *testing.T) {
+			do(t, "PATCH", "*", http.StatusOK, identity.JSONPatchDocument{
+				{Op: "replace", Path: "/traits/department", Value: "sales"},
+			})
+			do(t, "PUT", "", http.StatusOK, update("engineering"))
+		})
+	})
+
 	t.Run("case=should not be able to update an identity that does not exist yet", func(t *testing.T) {
 		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
 			t.Run("endpoint="+name, func(t *testing.T) {
@@ -591,4 +1258,55 @@ func TestHandler(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("case=should list and update the addresses of identities", func(t *testing.T) {
+		for name, ts := range map[string]*httptest.Server{"public": publicTS, "admin": adminTS} {
+			t.Run("endpoint="+name, func(t *testing.T) {
+				email := x.NewUUID().String() + "@ory.sh"
+				var cr identity.AdminCreateIdentityBody
+				cr.SchemaID = "customer"
+				cr.Traits = []byte(`{"email": "` + email + `"}`)
+				created := send(t, ts, "POST", "/identities", http.StatusCreated, &cr)
+				addressID := created.Get("verifiable_addresses.0.id").String()
+				require.NotEmpty(t, addressID, "%s", created.Raw)
+
+				find := func(res gjson.Result) gjson.Result {
+					return res.Get(`#(value=="` + email + `")`)
+				}
+
+				res := get(t, ts, "/verifiable-addresses?status=pending", http.StatusOK)
+				assert.EqualValues(t, created.Get("id").String(), find(res).Get("identity_id").String(), "%s", res.Raw)
+				res = get(t, ts, "/verifiable-addresses?status=completed", http.StatusOK)
+				assert.False(t, find(res).Exists(), "%s", res.Raw)
+
+				res = send(t, ts, "PUT", "/verifiable-addresses/"+addressID, http.StatusOK, json.RawMessage(`{"verified": true}`))
+				assert.True(t, res.Get("verified").Bool(), "%s", res.Raw)
+				assert.EqualValues(t, identity.VerifiableAddressStatusCompleted, res.Get("status").String(), "%s", res.Raw)
+				assert.NotEmpty(t, res.Get("verified_at").String(), "%s", res.Raw)
+
+				res = get(t, ts, "/verifiable-addresses?status=completed", http.StatusOK)
+				assert.True(t, find(res).Get("verified").Bool(), "%s", res.Raw)
+				res = get(t, ts, "/identities/"+created.Get("id").String(), http.StatusOK)
+				assert.True(t, res.Get("verifiable_addresses.0.verified").Bool(), "%s", res.Raw)
+				assert.EqualValues(t, email, res.Get("traits.email").String(), "%s", res.Raw)
+
+				res = send(t, ts, "PUT", "/verifiable-addresses/"+addressID, http.StatusOK, json.RawMessage(`{"verified": false}`))
+				assert.False(t, res.Get("verified").Bool(), "%s", res.Raw)
+				assert.EqualValues(t, identity.VerifiableAddressStatusPending, res.Get("status").String(), "%s", res.Raw)
+				assert.False(t, res.Get("verified_at").Exists(), "%s", res.Raw)
+
+				res = get(t, ts, "/recovery-addresses", http.StatusOK)
+				assert.EqualValues(t, created.Get("id").String(), find(res).Get("identity_id").String(), "%s", res.Raw)
+			})
+		}
+
+		t.Run("case=fails with an invalid status", func(t *testing.T) {
+			res := get(t, adminTS, "/verifiable-addresses?status=unknown", http.StatusBadRequest)
+			assert.Contains(t, res.Get("error.reason").String(), "status", "%s", res.Raw)
+		})
+
+		t.Run("case=fails to update an unknown address", func(t *testing.T) {
+			send(t, adminTS, "PUT", "/verifiable-addresses/"+x.NewUUID().String(), http.StatusNotFound, json.RawMessage(`{"verified": true}`))
+		})
+	})
 }
@@ -0,0 +1,202 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/ory/x/sqlxx"
+
+	"github.com/ory/kratos/corp"
+)
+
+// HistoryCause describes what caused a change to an identity.
+type HistoryCause string
+
+const (
+	// HistoryCauseAdminAPI is used for changes made using the admin API.
+	HistoryCauseAdminAPI HistoryCause = "admin_api"
+
+	// HistoryCauseSettingsFlow is used for changes made by the identity itself using a settings flow,
+	// including changes made by the flow's hooks.
+	HistoryCauseSettingsFlow HistoryCause = "settings_flow"
+
+	// HistoryCauseSystem is used for all other changes.
+	HistoryCauseSystem HistoryCause = "system"
+)
+
+type historyCauseContextKey struct{}
+
+// WithHistoryCause returns a context which attributes all identity changes made with it to the given cause.
+func WithHistoryCause(ctx context.Context, cause HistoryCause) context.Context {
+	return context.WithValue(ctx, historyCauseContextKey{}, cause)
+}
+
+// HistoryCauseFromContext returns the cause set using WithHistoryCause or HistoryCauseSystem if none was set.
+func HistoryCauseFromContext(ctx context.Context) HistoryCause {
+	if cause, ok := ctx.Value(historyCauseContextKey{}).(HistoryCause); ok {
+		return cause
+	}
+	return HistoryCauseSystem
+}
+
+// AttributeChangesTo wraps a handler so that all identity changes it makes are attributed to the given cause.
+func AttributeChangesTo(cause HistoryCause, h httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		h(w, r.WithContext(WithHistoryCause(r.Context(), cause)), ps)
+	}
+}
+
+// A change of an identity's traits, state, or credential identifiers.
+//
+// swagger:model identityHistoryEntry
+type HistoryEntry struct {
+	// ID is the ID of this entry.
+	//
+	// required: true
+	ID uuid.UUID `json:"id" faker:"-" db:"id"`
+
+	// IdentityID is the ID of the identity which was changed.
+	//
+	// required: true
+	IdentityID uuid.UUID `json:"identity_id" faker:"-" db:"identity_id"`
+
+	// Cause describes what caused the change. One of `admin_api`, `settings_flow`, or `system`.
+	//
+	// required: true
+	Cause HistoryCause `json:"cause" db:"cause"`
+
+	// Changes lists the fields which were changed.
+	//
+	// required: true
+	Changes HistoryChanges `json:"changes" faker:"-" db:"changes"`
+
+	// CreatedAt is the time at which the change was made.
+	//
+	// required: true
+	CreatedAt time.Time `json:"created_at" faker:"-" db:"created_at"`
+
+	// UpdatedAt is a helper struct field for gobuffalo.pop.
+	UpdatedAt time.Time `json:"-" faker:"-" db:"updated_at"`
+	NID       uuid.UUID `json:"-" faker:"-" db:"nid"`
+}
+
+func (h HistoryEntry) TableName(ctx context.Context) string {
+	return corp.ContextualizeTableName(ctx, "identity_history")
+}
+
+// A single field of an identity which was changed.
+//
+// swagger:model identityHistoryChange
+type HistoryChange struct {
+	// Field is the changed field, e.g. `traits`, `state`, or `credentials.password.identifiers`.
+	//
+	// required: true
+	Field string `json:"field"`
+
+	// Old is the value before the change.
+	Old json.RawMessage `json:"old"`
+
+	// New is the value after the change.
+	New json.RawMessage `json:"new"`
+}
+
+// HistoryChanges is a list of changes which is stored as JSON.
+type HistoryChanges []HistoryChange
+
+func (c *HistoryChanges) Scan(value interface{}) error {
+	return sqlxx.JSONScan(c, value)
+}
+
+func (c HistoryChanges) Value() (driver.Value, error) {
+	return sqlxx.JSONValue(c)
+}
+
+// NewHistoryChanges returns the changes of the traits, state, external ID, and credential identifiers between the two
+// versions of an identity. It returns an empty list if none of these fields changed.
+func NewHistoryChanges(original, updated *Identity) (HistoryChanges, error) {
+	var changes HistoryChanges
+	add := func(field string, o, n interface{}) error {
+		ob, err := normalizedJSON(o)
+		if err != nil {
+			return err
+		}
+		nb, err := normalizedJSON(n)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(ob, nb) {
+			changes = append(changes, HistoryChange{Field: field, Old: ob, New: nb})
+		}
+		return nil
+	}
+
+	if err := add("traits", original.Traits, updated.Traits); err != nil {
+		return nil, err
+	}
+
+	if err := add("state", original.State, updated.State); err != nil {
+		return nil, err
+	}
+
+	if err := add("external_id", original.ExternalID, updated.ExternalID); err != nil {
+		return nil, err
+	}
+
+	types := map[CredentialsType]bool{}
+	for t := range original.Credentials {
+		types[t] = true
+	}
+	for t := range updated.Credentials {
+		types[t] = true
+	}
+	sorted := make([]string, 0, len(types))
+	for t := range types {
+		sorted = append(sorted, string(t))
+	}
+	sort.Strings(sorted)
+
+	for _, t := range sorted {
+		if err := add(
+			fmt.Sprintf("credentials.%s.identifiers", t),
+			sortedIdentifiers(original.Credentials[CredentialsType(t)]),
+			sortedIdentifiers(updated.Credentials[CredentialsType(t)]),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+func sortedIdentifiers(c Credentials) []string {
+	identifiers := append([]string{}, c.Identifiers...)
+	sort.Strings(identifiers)
+	return identifiers
+}
+
+// normalizedJSON encodes v with sorted object keys so that equal values have equal encodings regardless
+// of how the database stored them.
+func normalizedJSON(v interface{}) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	out, err := json.Marshal(decoded)
+	return out, errors.WithStack(err)
+}
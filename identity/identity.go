@@ -71,6 +71,10 @@ type Identity struct {
 	// required: true
 	ID uuid.UUID `json:"id" faker:"-" db:"id"`
 
+	// ExternalID is a unique identifier of the identity in another system, e.g. the ID of the customer
+	// in a billing system. It can only be set using the admin API.
+	ExternalID sqlxx.NullString `json:"external_id,omitempty" faker:"-" db:"external_id"`
+
 	// Credentials represents all credentials that can be used for authenticating this identity.
 	Credentials map[CredentialsType]Credentials `json:"credentials,omitempty" faker:"-" db:"-"`
 
@@ -90,12 +94,19 @@ type Identity struct {
 
 	// State is the identity's state.
 	//
-	// This value has currently no effect.
+	// Inactive identities can not sign in, use their sessions, or recover their account.
 	State State `json:"state" faker:"-" db:"state"`
 
 	// StateChangedAt contains the last time when the identity's state changed.
 	StateChangedAt *sqlxx.NullTime `json:"state_changed_at,omitempty" faker:"-" db:"state_changed_at"`
 
+	// StateReason is the reason given when the identity was deactivated.
+	StateReason sqlxx.NullString `json:"state_reason,omitempty" faker:"-" db:"state_reason"`
+
+	// ReactivateAt is the time at which a deactivated identity becomes active again. If unset, the
+	// identity stays inactive until its state is changed to `active`.
+	ReactivateAt *sqlxx.NullTime `json:"reactivate_at,omitempty" faker:"-" db:"reactivate_at"`
+
 	// Traits represent an identity's traits. The identity is able to create, modify, and delete traits
 	// in a self-service manner. The input will always be validated against the JSON Schema defined
 	// in `schema_url`.
@@ -119,12 +130,28 @@ type Identity struct {
 	// ---
 	RecoveryAddresses []RecoveryAddress `json:"recovery_addresses,omitempty" faker:"-" has_many:"identity_recovery_addresses" fk_id:"identity_id"`
 
+	// MetadataPublic is data about the identity which is readable by the identity itself, for
+	// example through the `/sessions/whoami` endpoint, but can only be modified using the admin API.
+	MetadataPublic sqlxx.NullJSONRawMessage `json:"metadata_public" faker:"-" db:"metadata_public"`
+
+	// MetadataAdmin is data about the identity which is only accessible through the admin API.
+	MetadataAdmin sqlxx.NullJSONRawMessage `json:"metadata_admin,omitempty" faker:"-" db:"metadata_admin"`
+
 	// CreatedAt is a helper struct field for gobuffalo.pop.
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 
 	// UpdatedAt is a helper struct field for gobuffalo.pop.
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
-	NID       uuid.UUID `json:"-"  faker:"-" db:"nid"`
+
+	// Version is incremented on every update and used to detect concurrent modifications. It
+	// is exposed as the ETag of the admin API.
+	Version int `json:"-" faker:"-" db:"version"`
+
+	// DeletedAt is set when the identity was soft-deleted. Soft-deleted identities are hidden from
+	// the pool until they are restored or purged.
+	DeletedAt *sqlxx.NullTime `json:"-" faker:"-" db:"deleted_at"`
+
+	NID uuid.UUID `json:"-"  faker:"-" db:"nid"`
 }
 
 // Traits represent an identity's traits. The identity is able to create, modify, and delete traits
@@ -174,8 +201,33 @@ func (i *Identity) lock() *sync.RWMutex {
 	return i.l
 }
 
+// IsActive returns true if the identity is active or if its deactivation has expired.
 func (i *Identity) IsActive() bool {
-	return i.State == StateActive
+	if i.State == StateActive {
+		return true
+	}
+	return i.ReactivateAt != nil && time.Now().After(time.Time(*i.ReactivateAt))
+}
+
+// SetState changes the identity's state. Changing the state resets the deactivation reason
+// and reactivation time.
+func (i *Identity) SetState(state State) {
+	stateChangedAt := sqlxx.NullTime(time.Now())
+	i.State = state
+	i.StateChangedAt = &stateChangedAt
+	i.StateReason = ""
+	i.ReactivateAt = nil
+}
+
+// Deactivate sets the identity's state to inactive. If reactivateAt is set, the identity
+// becomes active again once that time has passed.
+func (i *Identity) Deactivate(reason string, reactivateAt *time.Time) {
+	i.SetState(StateInactive)
+	i.StateReason = sqlxx.NullString(reason)
+	if reactivateAt != nil {
+		t := sqlxx.NullTime(*reactivateAt)
+		i.ReactivateAt = &t
+	}
 }
 
 func (i *Identity) SetCredentials(t CredentialsType, c Credentials) {
@@ -259,6 +311,7 @@ func (i Identity) GetNID() uuid.UUID {
 func (i Identity) MarshalJSON() ([]byte, error) {
 	type localIdentity Identity
 	i.Credentials = nil
+	i.MetadataAdmin = nil
 	result, err := json.Marshal(localIdentity(i))
 	if err != nil {
 		return nil, err
@@ -273,16 +326,28 @@ func (i *Identity) UnmarshalJSON(b []byte) error {
 	return err
 }
 
-type WithCredentialsInJSON Identity
+// WithAdminMetadataInJSON encodes the identity including its admin metadata but without credentials.
+type WithAdminMetadataInJSON Identity
+
+func (i WithAdminMetadataInJSON) MarshalJSON() ([]byte, error) {
+	type localIdentity Identity
+	i.Credentials = nil
+	return json.Marshal(localIdentity(i))
+}
+
+// WithCredentialsAndAdminMetadataInJSON encodes the identity including its credentials and admin metadata.
+type WithCredentialsAndAdminMetadataInJSON Identity
 
-func (i WithCredentialsInJSON) MarshalJSON() ([]byte, error) {
+func (i WithCredentialsAndAdminMetadataInJSON) MarshalJSON() ([]byte, error) {
 	type localIdentity Identity
 	return json.Marshal(localIdentity(i))
 }
 
-type WithCredentialsMetadataInJSON Identity
+// WithCredentialsMetadataAndAdminMetadataInJSON encodes the identity including its admin metadata and
+// its credentials without their configuration.
+type WithCredentialsMetadataAndAdminMetadataInJSON Identity
 
-func (i WithCredentialsMetadataInJSON) MarshalJSON() ([]byte, error) {
+func (i WithCredentialsMetadataAndAdminMetadataInJSON) MarshalJSON() ([]byte, error) {
 	type localIdentity Identity
 	for k, v := range i.Credentials {
 		v.Config = nil
@@ -376,3 +441,14 @@ func (i *Identity) WithDeclassifiedCredentialsOIDC(ctx context.Context, c cipher
 	ii.Credentials = credsToPublish
 	return &ii, nil
 }
+
+// SetCredentialsWithConfig sets the credentials of type t and encodes config as the credentials' configuration.
+func (i *Identity) SetCredentialsWithConfig(t CredentialsType, c Credentials, config interface{}) (err error) {
+	c.Config, err = json.Marshal(config)
+	if err != nil {
+		return errors.WithStack(x.PseudoPanic.WithDebugf("Unable to encode %s credentials configuration to JSON: %s", t, err))
+	}
+
+	i.SetCredentials(t, c)
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 const (
 	RecoveryAddressTypeEmail RecoveryAddressType = AddressTypeEmail
+	RecoveryAddressTypeSMS   RecoveryAddressType = AddressTypePhone
 )
 
 type (
@@ -45,6 +46,8 @@ func (v RecoveryAddressType) HTMLFormInputType() string {
 	switch v {
 	case RecoveryAddressTypeEmail:
 		return "email"
+	case RecoveryAddressTypeSMS:
+		return "tel"
 	}
 	return ""
 }
@@ -67,3 +70,14 @@ func NewRecoveryEmailAddress(
 		IdentityID: identity,
 	}
 }
+
+func NewRecoverySMSAddress(
+	value string,
+	identity uuid.UUID,
+) *RecoveryAddress {
+	return &RecoveryAddress{
+		Value:      value,
+		Via:        RecoveryAddressTypeSMS,
+		IdentityID: identity,
+	}
+}
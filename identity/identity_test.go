@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ory/kratos/x"
 
@@ -113,7 +114,7 @@ func TestMarshalIdentityWithCredentialsWhenCredentialsNil(t *testing.T) {
 	i.Credentials = nil
 
 	var b bytes.Buffer
-	require.Nil(t, json.NewEncoder(&b).Encode(WithCredentialsMetadataInJSON(*i)))
+	require.Nil(t, json.NewEncoder(&b).Encode(WithCredentialsMetadataAndAdminMetadataInJSON(*i)))
 
 	assert.False(t, gjson.Get(b.String(), "credentials").Exists())
 }
@@ -129,7 +130,7 @@ func TestMarshalIdentityWithCredentials(t *testing.T) {
 	i.Credentials = credentials
 
 	var b bytes.Buffer
-	require.Nil(t, json.NewEncoder(&b).Encode(WithCredentialsMetadataInJSON(*i)))
+	require.Nil(t, json.NewEncoder(&b).Encode(WithCredentialsMetadataAndAdminMetadataInJSON(*i)))
 
 	credentialsInJson := gjson.Get(b.String(), "credentials")
 	assert.True(t, credentialsInJson.Exists())
@@ -164,3 +165,52 @@ func TestValidateNID(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalIdentityWithAdminMetadata(t *testing.T) {
+	i := NewIdentity(config.DefaultIdentityTraitsSchemaID)
+	i.MetadataPublic = sqlxx.NullJSONRawMessage(`{"public":"data"}`)
+	i.MetadataAdmin = sqlxx.NullJSONRawMessage(`{"admin":"data"}`)
+
+	for _, tc := range []struct {
+		d         string
+		i         interface{}
+		withAdmin bool
+	}{
+		{d: "identity", i: i},
+		{d: "with admin metadata", i: WithAdminMetadataInJSON(*i), withAdmin: true},
+		{d: "with credentials and admin metadata", i: WithCredentialsAndAdminMetadataInJSON(*i), withAdmin: true},
+		{d: "with credentials metadata and admin metadata", i: WithCredentialsMetadataAndAdminMetadataInJSON(*i), withAdmin: true},
+	} {
+		t.Run("case="+tc.d, func(t *testing.T) {
+			var b bytes.Buffer
+			require.NoError(t, json.NewEncoder(&b).Encode(tc.i))
+
+			assert.JSONEq(t, `{"public":"data"}`, gjson.Get(b.String(), "metadata_public").Raw)
+			assert.Equal(t, tc.withAdmin, gjson.Get(b.String(), "metadata_admin").Exists(), b.String())
+		})
+	}
+}
+
+func TestIdentityState(t *testing.T) {
+	i := NewIdentity(config.DefaultIdentityTraitsSchemaID)
+	assert.True(t, i.IsActive())
+
+	i.Deactivate("suspicious activity", nil)
+	assert.False(t, i.IsActive())
+	assert.EqualValues(t, StateInactive, i.State)
+	assert.EqualValues(t, "suspicious activity", i.StateReason)
+	require.NotNil(t, i.StateChangedAt)
+
+	future := time.Now().Add(time.Hour)
+	i.Deactivate("", &future)
+	assert.False(t, i.IsActive())
+
+	past := time.Now().Add(-time.Hour)
+	i.Deactivate("", &past)
+	assert.True(t, i.IsActive())
+
+	i.SetState(StateActive)
+	assert.True(t, i.IsActive())
+	assert.Empty(t, i.StateReason)
+	assert.Nil(t, i.ReactivateAt)
+}
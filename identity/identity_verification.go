@@ -7,12 +7,14 @@ import (
 	"github.com/ory/kratos/corp"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/ory/x/sqlxx"
 )
 
 const (
 	VerifiableAddressTypeEmail VerifiableAddressType = AddressTypeEmail
+	VerifiableAddressTypeSMS   VerifiableAddressType = AddressTypePhone
 
 	VerifiableAddressStatusPending   VerifiableAddressStatus = "pending"
 	VerifiableAddressStatusSent      VerifiableAddressStatus = "sent"
@@ -29,6 +31,14 @@ type VerifiableAddressType string
 // swagger:model identityVerifiableAddressStatus
 type VerifiableAddressStatus string
 
+func (s VerifiableAddressStatus) IsValid() error {
+	switch s {
+	case VerifiableAddressStatusPending, VerifiableAddressStatusSent, VerifiableAddressStatusCompleted:
+		return nil
+	}
+	return errors.New("verifiable address status is not valid")
+}
+
 // VerifiableAddress is an identity's verifiable address
 //
 // swagger:model verifiableIdentityAddress
@@ -52,7 +62,7 @@ type VerifiableAddress struct {
 
 	// The delivery method
 	//
-	// enum: ["email"]
+	// enum: ["email","sms"]
 	// example: email
 	// required: true
 	Via VerifiableAddressType `json:"via" db:"via"`
@@ -90,6 +100,8 @@ func (v VerifiableAddressType) HTMLFormInputType() string {
 	switch v {
 	case VerifiableAddressTypeEmail:
 		return "email"
+	case VerifiableAddressTypeSMS:
+		return "tel"
 	}
 	return ""
 }
@@ -108,6 +120,16 @@ func NewVerifiableEmailAddress(value string, identity uuid.UUID) *VerifiableAddr
 	}
 }
 
+func NewVerifiableSMSAddress(value string, identity uuid.UUID) *VerifiableAddress {
+	return &VerifiableAddress{
+		Value:      value,
+		Verified:   false,
+		Status:     VerifiableAddressStatusPending,
+		Via:        VerifiableAddressTypeSMS,
+		IdentityID: identity,
+	}
+}
+
 func (a VerifiableAddress) GetID() uuid.UUID {
 	return a.ID
 }
@@ -14,16 +14,24 @@ import (
 	"github.com/ory/x/errorsx"
 
 	"github.com/ory/kratos/courier"
+	"github.com/ory/kratos/hash"
+	"github.com/ory/kratos/schema"
+	"github.com/ory/kratos/text"
 )
 
 var ErrProtectedFieldModified = herodot.ErrForbidden.
 	WithReasonf(`A field was modified that updates one or more credentials-related settings. This action was blocked because an unprivileged method was used to execute the update. This is either a configuration issue or a bug and should be reported to the system administrator.`)
 
+var ErrConcurrentUpdate = herodot.ErrConflict.
+	WithID(text.ErrIDIdentityModified).
+	WithReasonf(`The identity was modified by another request in the meantime. Please fetch the identity again and retry the update.`)
+
 type (
 	managerDependencies interface {
 		PoolProvider
 		courier.Provider
 		ValidationProvider
+		hash.HashProvider
 	}
 	ManagementProvider interface {
 		IdentityManager() *Manager
@@ -35,6 +43,7 @@ type (
 	managerOptions struct {
 		ExposeValidationErrors    bool
 		AllowWriteProtectedTraits bool
+		ExpectedVersion           *int
 	}
 
 	ManagerOption func(*managerOptions)
@@ -52,6 +61,13 @@ func ManagerAllowWriteProtectedTraits(options *managerOptions) {
 	options.AllowWriteProtectedTraits = true
 }
 
+// ManagerExpectVersion fails the update with ErrConcurrentUpdate unless the stored identity has the given version.
+func ManagerExpectVersion(version int) ManagerOption {
+	return func(options *managerOptions) {
+		options.ExpectedVersion = &version
+	}
+}
+
 func newManagerOptions(opts []ManagerOption) *managerOptions {
 	var o managerOptions
 	for _, f := range opts {
@@ -69,6 +85,13 @@ func (m *Manager) Create(ctx context.Context, i *Identity, opts ...ManagerOption
 	return m.r.IdentityPool().(PrivilegedPool).CreateIdentity(ctx, i)
 }
 
+func (m *Manager) requiresVersion(original *Identity, o *managerOptions) error {
+	if o.ExpectedVersion != nil && *o.ExpectedVersion != original.Version {
+		return errors.WithStack(ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 func (m *Manager) requiresPrivilegedAccess(_ context.Context, original, updated *Identity, o *managerOptions) error {
 	if !o.AllowWriteProtectedTraits {
 		if !CredentialsEqual(updated.Credentials, original.Credentials) {
@@ -99,6 +122,10 @@ func (m *Manager) Update(ctx context.Context, updated *Identity, opts ...Manager
 		return err
 	}
 
+	if err := m.requiresVersion(original, o); err != nil {
+		return err
+	}
+
 	if err := m.requiresPrivilegedAccess(ctx, original, updated, o); err != nil {
 		return err
 	}
@@ -157,8 +184,17 @@ func (m *Manager) UpdateTraits(ctx context.Context, id uuid.UUID, traits Traits,
 
 func (m *Manager) validate(ctx context.Context, i *Identity, o *managerOptions) error {
 	if err := m.r.IdentityValidator().Validate(ctx, i); err != nil {
-		if _, ok := errorsx.Cause(err).(*jsonschema.ValidationError); ok && !o.ExposeValidationErrors {
+		if o.ExposeValidationErrors {
+			return err
+		}
+
+		switch e := errorsx.Cause(err).(type) {
+		case *jsonschema.ValidationError:
 			return herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err)
+		case *schema.ValidationError:
+			if _, ok := e.Context.(*schema.ValidationErrorContextDuplicateTraitError); ok {
+				return errors.WithStack(herodot.ErrConflict.WithReasonf("%s", e.Message).WithWrap(err))
+			}
 		}
 		return err
 	}
@@ -126,7 +126,7 @@ func TestManager(t *testing.T) {
 			require.NoError(t, err)
 			checkExtensionFields(fromStore, newEmail)(t)
 
-			recoveryAddresses, err := reg.PrivilegedIdentityPool().ListRecoveryAddresses(context.Background(), 0, 500)
+			recoveryAddresses, err := reg.PrivilegedIdentityPool().ListRecoveryAddresses(context.Background(), x.KeysetPage(500))
 			require.NoError(t, err)
 
 			var foundRecoveryAddress bool
@@ -138,7 +138,7 @@ func TestManager(t *testing.T) {
 			}
 			require.True(t, foundRecoveryAddress)
 
-			verifiableAddresses, err := reg.PrivilegedIdentityPool().ListVerifiableAddresses(context.Background(), 0, 500)
+			verifiableAddresses, err := reg.PrivilegedIdentityPool().ListVerifiableAddresses(context.Background(), identity.ListVerifiableAddressesFilter{}, x.KeysetPage(500))
 			require.NoError(t, err)
 			var foundVerifiableAddress bool
 			for _, a := range verifiableAddresses {
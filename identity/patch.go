@@ -0,0 +1,144 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/gofrs/uuid"
+	"github.com/mohae/deepcopy"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/jsonx"
+	"github.com/ory/x/sqlxx"
+)
+
+// A JSONPatch operation as defined by RFC 6902
+//
+// swagger:model jsonPatch
+type JSONPatch struct {
+	// The operation to be performed. One of "add", "remove", "replace", "move", "copy", or "test".
+	//
+	// required: true
+	// example: replace
+	Op string `json:"op"`
+
+	// The path to the target path. Uses JSON pointer notation.
+	//
+	// Learn more [about JSON Pointers](https://datatracker.ietf.org/doc/html/rfc6901#section-5).
+	//
+	// required: true
+	// example: /traits/email
+	Path string `json:"path"`
+
+	// The value to be used within the operations.
+	//
+	// example: foo@example.com
+	Value interface{} `json:"value,omitempty"`
+
+	// This field is used together with operation "move" and uses JSON Pointer notation.
+	//
+	// Learn more [about JSON Pointers](https://datatracker.ietf.org/doc/html/rfc6901#section-5).
+	//
+	// example: /traits/name
+	From string `json:"from,omitempty"`
+}
+
+// A JSONPatchDocument request as defined by RFC 6902
+//
+// swagger:model jsonPatchDocument
+type JSONPatchDocument []JSONPatch
+
+// patchableIdentity contains the identity fields which can be modified using JSON Patch.
+type patchableIdentity struct {
+	Traits         Traits                   `json:"traits"`
+	State          State                    `json:"state"`
+	MetadataPublic sqlxx.NullJSONRawMessage `json:"metadata_public"`
+	MetadataAdmin  sqlxx.NullJSONRawMessage `json:"metadata_admin"`
+	ExternalID     sqlxx.NullString         `json:"external_id,omitempty"`
+}
+
+// Patch applies the RFC 6902 JSON Patch to the identity with the given ID, validates the result against the
+// identity's schema, and stores it. Reading, patching, and storing the identity happens within one transaction.
+func (m *Manager) Patch(ctx context.Context, id uuid.UUID, patch []byte, opts ...ManagerOption) (*Identity, error) {
+	p, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("The JSON Patch is invalid: %s", err).WithWrap(err))
+	}
+
+	var updated *Identity
+	if err := m.transaction(ctx, func(ctx context.Context) error {
+		original, err := m.r.IdentityPool().(PrivilegedPool).GetIdentityConfidential(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		updated = deepcopy.Copy(original).(*Identity)
+		if err := applyJSONPatch(p, updated); err != nil {
+			return err
+		}
+
+		return m.Update(ctx, updated, opts...)
+	}); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func applyJSONPatch(p jsonpatch.Patch, i *Identity) error {
+	doc, err := json.Marshal(&patchableIdentity{
+		Traits:         i.Traits,
+		State:          i.State,
+		MetadataPublic: i.MetadataPublic,
+		MetadataAdmin:  i.MetadataAdmin,
+		ExternalID:     i.ExternalID,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	doc, err = p.Apply(doc)
+	if errors.Is(err, jsonpatch.ErrTestFailed) {
+		return errors.WithStack(herodot.ErrConflict.WithReasonf("The JSON Patch could not be applied: %s", err).WithWrap(err))
+	} else if err != nil {
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("The JSON Patch could not be applied: %s", err).WithWrap(err))
+	}
+
+	var patched patchableIdentity
+	if err := jsonx.NewStrictDecoder(bytes.NewReader(doc)).Decode(&patched); err != nil {
+		return errors.WithStack(herodot.ErrBadRequest.WithReasonf("The JSON Patch may only modify the fields `traits`, `state`, `metadata_public`, `metadata_admin`, and `external_id`: %s", err).WithWrap(err))
+	}
+
+	if len(patched.Traits) == 0 {
+		return errors.WithStack(herodot.ErrBadRequest.WithReason("The JSON Patch must not remove the identity's traits."))
+	}
+
+	if patched.State != i.State {
+		if err := patched.State.IsValid(); err != nil {
+			return errors.WithStack(herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err))
+		}
+
+		i.SetState(patched.State)
+	}
+
+	if err := validateExternalID(string(patched.ExternalID)); err != nil {
+		return err
+	}
+
+	i.Traits = patched.Traits
+	i.MetadataPublic = nullIfEmpty(patched.MetadataPublic)
+	i.MetadataAdmin = nullIfEmpty(patched.MetadataAdmin)
+	i.ExternalID = patched.ExternalID
+	return nil
+}
+
+// nullIfEmpty stores removed and `null` metadata as SQL NULL.
+func nullIfEmpty(m sqlxx.NullJSONRawMessage) sqlxx.NullJSONRawMessage {
+	if len(m) == 0 || string(m) == "null" {
+		return nil
+	}
+	return m
+}
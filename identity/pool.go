@@ -2,22 +2,62 @@ package identity
 
 import (
 	"context"
+	"time"
 
+	"github.com/gobuffalo/pop/v5"
 	"github.com/gofrs/uuid"
+
+	"github.com/ory/kratos/x"
 )
 
 type (
+	// ListIdentitiesFilter narrows down the identities returned by ListIdentities and counted by
+	// CountIdentities. Empty fields are ignored.
+	ListIdentitiesFilter struct {
+		// CredentialsIdentifier matches identities having a credential with exactly this identifier.
+		CredentialsIdentifier string
+
+		// CredentialsIdentifierPrefix matches identities having a credential identifier starting with this value.
+		CredentialsIdentifierPrefix string
+
+		// Traits matches identities whose traits at the given path (e.g. `email` or `name.first`) equal the value.
+		Traits map[string]string
+
+		// State matches identities in the given state.
+		State State
+
+		// SchemaID matches identities using the given identity schema.
+		SchemaID string
+
+		// CreatedAfter matches identities created at or after this time.
+		CreatedAfter time.Time
+
+		// CreatedBefore matches identities created at or before this time.
+		CreatedBefore time.Time
+	}
+
+	// ListVerifiableAddressesFilter narrows down the addresses returned by ListVerifiableAddresses. Empty fields
+	// are ignored.
+	ListVerifiableAddressesFilter struct {
+		// Status matches addresses in the given verification status.
+		Status VerifiableAddressStatus
+	}
+
 	Pool interface {
-		// ListIdentities lists all identities in the store given the page and itemsPerPage.
-		ListIdentities(ctx context.Context, page, itemsPerPage int) ([]Identity, error)
+		// ListIdentities lists the identities on the given page matching the filter, ordered by descending ID.
+		ListIdentities(ctx context.Context, filter ListIdentitiesFilter, page x.Page) ([]Identity, error)
 
-		// CountIdentities counts the number of identities in the store.
-		CountIdentities(ctx context.Context) (int64, error)
+		// CountIdentities counts the number of identities in the store matching the filter.
+		CountIdentities(ctx context.Context, filter ListIdentitiesFilter) (int64, error)
 
 		// GetIdentity returns an identity by its id. Will return an error if the identity does not exist or backend
 		// connectivity is broken.
 		GetIdentity(context.Context, uuid.UUID) (*Identity, error)
 
+		// FindIdentityByExternalID returns the identity with the given external ID or sql.ErrNoRows if no identity
+		// could be found.
+		FindIdentityByExternalID(ctx context.Context, externalID string) (*Identity, error)
+
 		// FindVerifiableAddressByValue returns a matching address or sql.ErrNoRows if no address could be found.
 		FindVerifiableAddressByValue(ctx context.Context, via VerifiableAddressType, address string) (*VerifiableAddress, error)
 
@@ -25,6 +65,11 @@ type (
 		FindRecoveryAddressByValue(ctx context.Context, via RecoveryAddressType, address string) (*RecoveryAddress, error)
 	}
 
+	// Transactor is implemented by pools which are able to run a callback within a transaction.
+	Transactor interface {
+		Transaction(ctx context.Context, callback func(ctx context.Context, connection *pop.Connection) error) error
+	}
+
 	PoolProvider interface {
 		IdentityPool() Pool
 	}
@@ -39,10 +84,35 @@ type (
 		// FindByCredentialsIdentifier returns an identity by querying for it's credential identifiers.
 		FindByCredentialsIdentifier(ctx context.Context, ct CredentialsType, match string) (*Identity, *Credentials, error)
 
+		// FindIdentityIDByUniqueTrait returns the ID of the identity, including soft-deleted ones, which has the
+		// value at the path of a trait marked as unique, or sql.ErrNoRows if no identity has it.
+		FindIdentityIDByUniqueTrait(ctx context.Context, path, value string) (uuid.UUID, error)
+
 		// DeleteIdentity removes an identity by its id. Will return an error
 		// if identity exists, backend connectivity is broken, or trait validation fails.
+		//
+		// If soft deletion is enabled, the identity is only marked as deleted and its sessions are revoked.
 		DeleteIdentity(context.Context, uuid.UUID) error
 
+		// RestoreIdentity restores a soft-deleted identity. Will return sql.ErrNoRows if the identity does not
+		// exist, was not soft-deleted, or was deleted longer ago than the configured retention period.
+		RestoreIdentity(context.Context, uuid.UUID) error
+
+		// PurgeDeletedIdentities permanently deletes all identities which were soft-deleted before the given
+		// time and returns how many identities were purged.
+		PurgeDeletedIdentities(ctx context.Context, deletedBefore time.Time) (int, error)
+
+		// ListIdentityHistory lists the recorded changes of an identity which are within the retention period,
+		// newest first, and returns the total number of such changes.
+		ListIdentityHistory(ctx context.Context, id uuid.UUID, page, itemsPerPage int) ([]HistoryEntry, int64, error)
+
+		// PurgeIdentityHistory permanently deletes all recorded identity changes which were made before the
+		// given time and returns how many changes were purged.
+		PurgeIdentityHistory(ctx context.Context, before time.Time) (int, error)
+
+		// GetVerifiableAddress returns a verifiable address by its id or sql.ErrNoRows if no address could be found.
+		GetVerifiableAddress(ctx context.Context, id uuid.UUID) (*VerifiableAddress, error)
+
 		// UpdateVerifiableAddress updates an identity's verifiable address.
 		UpdateVerifiableAddress(ctx context.Context, address *VerifiableAddress) error
 
@@ -50,17 +120,19 @@ type (
 		// if identity exists, backend connectivity is broken, or trait validation fails.
 		CreateIdentity(context.Context, *Identity) error
 
-		// UpdateIdentity updates an identity including its confidential / privileged / protected data.
+		// UpdateIdentity updates an identity including its confidential / privileged / protected data. If the
+		// change history is enabled, changes of the traits, state, external ID, and credential identifiers are
+		// recorded.
 		UpdateIdentity(context.Context, *Identity) error
 
 		// GetIdentityConfidential returns the identity including it's raw credentials. This should only be used internally.
 		GetIdentityConfidential(context.Context, uuid.UUID) (*Identity, error)
 
-		// ListVerifiableAddresses lists all tracked verifiable addresses, regardless of whether they are already verified
-		// or not.
-		ListVerifiableAddresses(ctx context.Context, page, itemsPerPage int) ([]VerifiableAddress, error)
+		// ListVerifiableAddresses lists the tracked verifiable addresses matching the filter, regardless of whether they
+		// are already verified or not, ordered by descending ID.
+		ListVerifiableAddresses(ctx context.Context, filter ListVerifiableAddressesFilter, page x.Page) ([]VerifiableAddress, error)
 
-		// ListRecoveryAddresses lists all tracked recovery addresses.
-		ListRecoveryAddresses(ctx context.Context, page, itemsPerPage int) ([]RecoveryAddress, error)
+		// ListRecoveryAddresses lists all tracked recovery addresses, ordered by descending ID.
+		ListRecoveryAddresses(ctx context.Context, page x.Page) ([]RecoveryAddress, error)
 	}
 )
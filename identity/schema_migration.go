@@ -0,0 +1,162 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/google/go-jsonnet"
+	"github.com/mohae/deepcopy"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/herodot"
+
+	"github.com/ory/kratos/x"
+)
+
+// schemaMigrationPageSize is the number of identities loaded at once when migrating identity schemas.
+var schemaMigrationPageSize = 100
+
+// The result of migrating identities from one identity schema to another.
+//
+// swagger:model identitySchemaMigrationReport
+type SchemaMigrationReport struct {
+	// FromSchemaID is the ID of the identity schema the identities were migrated from.
+	//
+	// required: true
+	FromSchemaID string `json:"from_schema_id"`
+
+	// ToSchemaID is the ID of the identity schema the identities were migrated to.
+	//
+	// required: true
+	ToSchemaID string `json:"to_schema_id"`
+
+	// DryRun is true if the identities were only transformed and validated but not updated.
+	//
+	// required: true
+	DryRun bool `json:"dry_run"`
+
+	// Total is the number of identities which were using the source identity schema.
+	//
+	// required: true
+	Total int `json:"total"`
+
+	// Migrated is the number of identities which were migrated or, in a dry run, would have been migrated.
+	//
+	// required: true
+	Migrated int `json:"migrated"`
+
+	// Failures contains one entry per identity which could not be migrated.
+	//
+	// required: true
+	Failures []SchemaMigrationFailure `json:"failures"`
+}
+
+// An identity which could not be migrated to another identity schema.
+//
+// swagger:model identitySchemaMigrationFailure
+type SchemaMigrationFailure struct {
+	// IdentityID is the ID of the identity which could not be migrated.
+	//
+	// required: true
+	IdentityID uuid.UUID `json:"identity_id"`
+
+	// Error is the reason why the identity could not be migrated.
+	//
+	// required: true
+	Error *herodot.DefaultError `json:"error"`
+}
+
+// MigrateSchema moves all identities using identity schema from to identity schema to.
+//
+// If transform is not empty, it is evaluated as Jsonnet for every identity with the identity available as
+// `std.extVar('identity')`. The output's `identity.traits` replace the identity's traits. The result is validated
+// against the target schema. Identities which fail the transformation or the validation are reported and left
+// untouched, the other identities are updated one by one. If dryRun is true, no identity is updated.
+func (m *Manager) MigrateSchema(ctx context.Context, from, to, transform string, dryRun bool, opts ...ManagerOption) (*SchemaMigrationReport, error) {
+	o := newManagerOptions(opts)
+	if transform != "" {
+		if _, err := jsonnet.SnippetToAST("transform", transform); err != nil {
+			return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Unable to parse the Jsonnet transformation: %s", err).WithWrap(err))
+		}
+	}
+
+	report := &SchemaMigrationReport{FromSchemaID: from, ToSchemaID: to, DryRun: dryRun, Failures: []SchemaMigrationFailure{}}
+	pool := m.r.IdentityPool().(PrivilegedPool)
+	page := x.Page{ItemsPerPage: schemaMigrationPageSize}
+	for {
+		// Paginating by token instead of offset ensures that no identity is skipped even though migrated
+		// identities no longer match the filter.
+		is, err := pool.ListIdentities(ctx, ListIdentitiesFilter{SchemaID: from}, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for k := range is {
+			report.Total++
+			if err := m.migrateIdentitySchema(ctx, is[k].ID, to, transform, dryRun, o); err != nil {
+				report.Failures = append(report.Failures, SchemaMigrationFailure{
+					IdentityID: is[k].ID,
+					Error:      herodot.ToDefaultError(err, ""),
+				})
+				continue
+			}
+			report.Migrated++
+		}
+
+		if len(is) < page.ItemsPerPage {
+			return report, nil
+		}
+		page.Token = is[len(is)-1].ID
+	}
+}
+
+func (m *Manager) migrateIdentitySchema(ctx context.Context, id uuid.UUID, to, transform string, dryRun bool, o *managerOptions) error {
+	pool := m.r.IdentityPool().(PrivilegedPool)
+	original, err := pool.GetIdentityConfidential(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	updated := deepcopy.Copy(original).(*Identity)
+	updated.SchemaID = to
+	if transform != "" {
+		traits, err := transformTraits(original, transform)
+		if err != nil {
+			return err
+		}
+		updated.Traits = traits
+	}
+
+	if err := m.validate(ctx, updated, o); err != nil {
+		return err
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	return pool.UpdateIdentity(ctx, updated)
+}
+
+func transformTraits(i *Identity, transform string) (Traits, error) {
+	in, err := json.Marshal(WithAdminMetadataInJSON(*i))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	vm := jsonnet.MakeVM()
+	vm.ExtCode("identity", string(in))
+	evaluated, err := vm.EvaluateAnonymousSnippet("transform", transform)
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Unable to evaluate the Jsonnet transformation: %s", err).WithWrap(err))
+	}
+
+	traits := gjson.Get(evaluated, "identity.traits")
+	if !traits.IsObject() {
+		return nil, errors.WithStack(herodot.ErrBadRequest.WithReason("The Jsonnet transformation did not return an object for key identity.traits."))
+	}
+
+	return Traits(traits.Raw), nil
+}
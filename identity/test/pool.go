@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ory/herodot"
 	"github.com/ory/x/assertx"
 
 	"github.com/ory/kratos/internal/testhelpers"
@@ -51,10 +52,18 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 			URL:    urlx.ParseOrPanic("file://./stub/identity-2.schema.json"),
 			RawURL: "file://./stub/identity-2.schema.json",
 		}
+		uniqueSchema := schema.Schema{
+			ID:     "uniqueSchema",
+			URL:    urlx.ParseOrPanic("file://./stub/identity-unique.schema.json"),
+			RawURL: "file://./stub/identity-unique.schema.json",
+		}
 		conf.MustSet(config.ViperKeyDefaultIdentitySchemaURL, defaultSchema.RawURL)
 		conf.MustSet(config.ViperKeyIdentitySchemas, []config.Schema{{
 			ID:  altSchema.ID,
 			URL: altSchema.RawURL,
+		}, {
+			ID:  uniqueSchema.ID,
+			URL: uniqueSchema.RawURL,
 		}})
 
 		var createdIDs []uuid.UUID
@@ -95,13 +104,13 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 			assert.Equal(t, nid, i.NID)
 			createdIDs = append(createdIDs, i.ID)
 
-			count, err := p.CountIdentities(ctx)
+			count, err := p.CountIdentities(ctx, identity.ListIdentitiesFilter{})
 			require.NoError(t, err)
 			assert.EqualValues(t, int64(1), count)
 
 			t.Run("different network", func(t *testing.T) {
 				_, p := testhelpers.NewNetwork(t, ctx, p)
-				count, err := p.CountIdentities(ctx)
+				count, err := p.CountIdentities(ctx, identity.ListIdentitiesFilter{})
 				require.NoError(t, err)
 				assert.EqualValues(t, int64(0), count)
 			})
@@ -120,7 +129,7 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 			assert.Equal(t, defaultSchema.SchemaURL(exampleServerURL).String(), actual.SchemaURL)
 			assertEqual(t, expected, actual)
 
-			count, err := p.CountIdentities(ctx)
+			count, err := p.CountIdentities(ctx, identity.ListIdentitiesFilter{})
 			require.NoError(t, err)
 			assert.EqualValues(t, 2, count)
 
@@ -129,7 +138,7 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 				_, err := p.GetIdentity(ctx, expected.ID)
 				require.ErrorIs(t, err, sqlcon.ErrNoRows)
 
-				count, err := p.CountIdentities(ctx)
+				count, err := p.CountIdentities(ctx, identity.ListIdentitiesFilter{})
 				require.NoError(t, err)
 				assert.EqualValues(t, int64(0), count)
 			})
@@ -306,6 +315,27 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 			require.Contains(t, err.Error(), "malformed")
 		})
 
+		t.Run("case=fail to update because the identity was modified concurrently", func(t *testing.T) {
+			initial := oidcIdentity("", x.NewUUID().String())
+			require.NoError(t, p.CreateIdentity(ctx, initial))
+			createdIDs = append(createdIDs, initial.ID)
+
+			stale := initial.CopyWithoutCredentials()
+			stale.Traits = identity.Traits(`{"stale":"me"}`)
+
+			initial.Traits = identity.Traits(`{"update":"me"}`)
+			require.NoError(t, p.UpdateIdentity(ctx, initial))
+			assert.Equal(t, 1, initial.Version)
+
+			require.ErrorIs(t, p.UpdateIdentity(ctx, stale), identity.ErrConcurrentUpdate)
+			assert.Equal(t, 0, stale.Version)
+
+			actual, err := p.GetIdentity(ctx, initial.ID)
+			require.NoError(t, err)
+			assert.Equal(t, 1, actual.Version)
+			assert.JSONEq(t, `{"update":"me"}`, string(actual.Traits))
+		})
+
 		t.Run("case=should fail to insert identity because credentials from traits exist", func(t *testing.T) {
 			first := passwordIdentity("", "test-identity@ory.sh")
 			first.Traits = identity.Traits(`{}`)
@@ -378,6 +408,170 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 			require.Error(t, err)
 		})
 
+		t.Run("case=soft delete and restore an identity", func(t *testing.T) {
+			conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, true)
+			t.Cleanup(func() {
+				conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, false)
+			})
+
+			identifier := "soft-delete-" + x.NewUUID().String()
+			expected := passwordIdentity("", identifier)
+			expected.RecoveryAddresses = []identity.RecoveryAddress{{Via: identity.RecoveryAddressTypeEmail, Value: identifier + "@ory.sh"}}
+			require.NoError(t, p.CreateIdentity(ctx, expected))
+
+			t.Run("fails on different network", func(t *testing.T) {
+				_, p := testhelpers.NewNetwork(t, ctx, p)
+				require.ErrorIs(t, p.DeleteIdentity(ctx, expected.ID), sqlcon.ErrNoRows)
+			})
+
+			require.NoError(t, p.DeleteIdentity(ctx, expected.ID))
+
+			_, err := p.GetIdentity(ctx, expected.ID)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			_, err = p.GetIdentityConfidential(ctx, expected.ID)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			_, _, err = p.FindByCredentialsIdentifier(ctx, identity.CredentialsTypePassword, identifier)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			_, err = p.FindRecoveryAddressByValue(ctx, identity.RecoveryAddressTypeEmail, identifier+"@ory.sh")
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			count, err := p.CountIdentities(ctx, identity.ListIdentitiesFilter{CredentialsIdentifier: identifier})
+			require.NoError(t, err)
+			assert.EqualValues(t, 0, count)
+			require.ErrorIs(t, p.UpdateIdentity(ctx, expected), sqlcon.ErrNoRows)
+			require.ErrorIs(t, p.DeleteIdentity(ctx, expected.ID), sqlcon.ErrNoRows)
+
+			t.Run("restore fails on different network", func(t *testing.T) {
+				_, p := testhelpers.NewNetwork(t, ctx, p)
+				require.ErrorIs(t, p.RestoreIdentity(ctx, expected.ID), sqlcon.ErrNoRows)
+			})
+
+			require.NoError(t, p.RestoreIdentity(ctx, expected.ID))
+			require.ErrorIs(t, p.RestoreIdentity(ctx, expected.ID), sqlcon.ErrNoRows)
+
+			actual, _, err := p.FindByCredentialsIdentifier(ctx, identity.CredentialsTypePassword, identifier)
+			require.NoError(t, err)
+			assert.Equal(t, expected.ID, actual.ID)
+
+			conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, false)
+			require.NoError(t, p.DeleteIdentity(ctx, expected.ID))
+		})
+
+		t.Run("case=purge soft-deleted identities", func(t *testing.T) {
+			_, p := testhelpers.NewNetwork(t, ctx, p)
+			conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, true)
+			t.Cleanup(func() {
+				conf.MustSet(config.ViperKeyIdentitySoftDeleteEnabled, false)
+				conf.MustSet(config.ViperKeyIdentitySoftDeleteRetention, nil)
+			})
+
+			deleted, kept := identity.NewIdentity(""), identity.NewIdentity("")
+			require.NoError(t, p.CreateIdentity(ctx, deleted))
+			require.NoError(t, p.CreateIdentity(ctx, kept))
+			require.NoError(t, p.DeleteIdentity(ctx, deleted.ID))
+
+			t.Run("can not restore after the retention period", func(t *testing.T) {
+				conf.MustSet(config.ViperKeyIdentitySoftDeleteRetention, "1ns")
+				t.Cleanup(func() {
+					conf.MustSet(config.ViperKeyIdentitySoftDeleteRetention, nil)
+				})
+				require.ErrorIs(t, p.RestoreIdentity(ctx, deleted.ID), sqlcon.ErrNoRows)
+			})
+
+			count, err := p.PurgeDeletedIdentities(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 0, count)
+
+			count, err = p.PurgeDeletedIdentities(ctx, time.Now().Add(time.Minute))
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			require.ErrorIs(t, p.RestoreIdentity(ctx, deleted.ID), sqlcon.ErrNoRows)
+			_, err = p.GetIdentity(ctx, kept.ID)
+			require.NoError(t, err)
+		})
+
+		t.Run("case=record change history", func(t *testing.T) {
+			_, p := testhelpers.NewNetwork(t, ctx, p)
+			conf.MustSet(config.ViperKeyIdentityHistoryEnabled, true)
+			t.Cleanup(func() {
+				conf.MustSet(config.ViperKeyIdentityHistoryEnabled, false)
+				conf.MustSet(config.ViperKeyIdentityHistoryRetention, nil)
+			})
+
+			i := passwordIdentity("", "history-old-"+x.NewUUID().String())
+			i.Traits = identity.Traits(`{"bar":"old","email":"old@ory.sh"}`)
+			require.NoError(t, p.CreateIdentity(ctx, i))
+			oldIdentifier := i.Credentials[identity.CredentialsTypePassword].Identifiers[0]
+
+			// Unchanged traits in a different key order are not recorded.
+			i.Traits = identity.Traits(`{"email": "old@ory.sh", "bar": "old"}`)
+			require.NoError(t, p.UpdateIdentity(ctx, i))
+
+			i.Traits = identity.Traits(`{"bar":"new","email":"new@ory.sh"}`)
+			require.NoError(t, p.UpdateIdentity(identity.WithHistoryCause(ctx, identity.HistoryCauseAdminAPI), i))
+
+			newIdentifier := "history-new-" + x.NewUUID().String()
+			creds := i.Credentials[identity.CredentialsTypePassword]
+			creds.Identifiers = []string{newIdentifier}
+			i.SetCredentials(identity.CredentialsTypePassword, creds)
+			i.SetState(identity.StateInactive)
+			require.NoError(t, p.UpdateIdentity(ctx, i))
+
+			entries, total, err := p.ListIdentityHistory(ctx, i.ID, 0, 10)
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, total)
+			require.Len(t, entries, 2)
+
+			assert.Equal(t, identity.HistoryCauseSystem, entries[0].Cause)
+			require.Len(t, entries[0].Changes, 2)
+			assert.Equal(t, "state", entries[0].Changes[0].Field)
+			assert.JSONEq(t, `"active"`, string(entries[0].Changes[0].Old))
+			assert.JSONEq(t, `"inactive"`, string(entries[0].Changes[0].New))
+			assert.Equal(t, "credentials.password.identifiers", entries[0].Changes[1].Field)
+			assert.JSONEq(t, `["`+oldIdentifier+`"]`, string(entries[0].Changes[1].Old))
+			assert.JSONEq(t, `["`+newIdentifier+`"]`, string(entries[0].Changes[1].New))
+
+			assert.Equal(t, identity.HistoryCauseAdminAPI, entries[1].Cause)
+			require.Len(t, entries[1].Changes, 1)
+			assert.Equal(t, "traits", entries[1].Changes[0].Field)
+			assert.JSONEq(t, `{"bar":"old","email":"old@ory.sh"}`, string(entries[1].Changes[0].Old))
+			assert.JSONEq(t, `{"bar":"new","email":"new@ory.sh"}`, string(entries[1].Changes[0].New))
+
+			t.Run("does not record changes if disabled", func(t *testing.T) {
+				conf.MustSet(config.ViperKeyIdentityHistoryEnabled, false)
+				t.Cleanup(func() {
+					conf.MustSet(config.ViperKeyIdentityHistoryEnabled, true)
+				})
+
+				i.Traits = identity.Traits(`{"bar":"ignored"}`)
+				require.NoError(t, p.UpdateIdentity(ctx, i))
+
+				_, total, err := p.ListIdentityHistory(ctx, i.ID, 0, 10)
+				require.NoError(t, err)
+				assert.EqualValues(t, 2, total)
+			})
+
+			t.Run("does not list changes after the retention period", func(t *testing.T) {
+				conf.MustSet(config.ViperKeyIdentityHistoryRetention, "1ns")
+				t.Cleanup(func() {
+					conf.MustSet(config.ViperKeyIdentityHistoryRetention, nil)
+				})
+
+				entries, total, err := p.ListIdentityHistory(ctx, i.ID, 0, 10)
+				require.NoError(t, err)
+				assert.EqualValues(t, 0, total)
+				assert.Len(t, entries, 0)
+			})
+
+			count, err := p.PurgeIdentityHistory(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 0, count)
+
+			count, err = p.PurgeIdentityHistory(ctx, time.Now().Add(time.Minute))
+			require.NoError(t, err)
+			assert.Equal(t, 2, count)
+		})
+
 		t.Run("case=create with empty credentials config", func(t *testing.T) {
 			// This test covers a case where the config value of a credentials setting is empty. This causes
 			// issues with postgres' json field.
@@ -392,7 +586,7 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 		})
 
 		t.Run("case=list", func(t *testing.T) {
-			is, err := p.ListIdentities(ctx, 0, 25)
+			is, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, x.KeysetPage(25))
 			require.NoError(t, err)
 			assert.Len(t, is, len(createdIDs))
 			for _, id := range createdIDs {
@@ -410,9 +604,182 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 
 			t.Run("no results on other network", func(t *testing.T) {
 				_, p := testhelpers.NewNetwork(t, ctx, p)
-				is, err := p.ListIdentities(ctx, 0, 25)
+				is, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, x.KeysetPage(25))
+				require.NoError(t, err)
+				assert.Len(t, is, 0)
+			})
+		})
+
+		t.Run("case=list with pagination", func(t *testing.T) {
+			_, p := testhelpers.NewNetwork(t, ctx, p)
+
+			var created []uuid.UUID
+			for k := 0; k < 5; k++ {
+				i := identity.NewIdentity(defaultSchema.ID)
+				require.NoError(t, p.CreateIdentity(ctx, i))
+				created = append(created, i.ID)
+			}
+
+			t.Run("keyset", func(t *testing.T) {
+				var listed []uuid.UUID
+				page := x.KeysetPage(2)
+				for k := 0; k < 3; k++ {
+					is, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, page)
+					require.NoError(t, err)
+					if k < 2 {
+						require.Len(t, is, 2)
+					} else {
+						require.Len(t, is, 1)
+					}
+
+					for _, i := range is {
+						listed = append(listed, i.ID)
+					}
+					page = page.Next(is[len(is)-1].ID)
+				}
+
+				is, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, page)
 				require.NoError(t, err)
 				assert.Len(t, is, 0)
+
+				assert.ElementsMatch(t, created, listed)
+				for k := 1; k < len(listed); k++ {
+					assert.True(t, listed[k-1].String() > listed[k].String(), "identities must be ordered by descending ID")
+				}
+			})
+
+			t.Run("keyset is stable when items are added", func(t *testing.T) {
+				first, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, x.KeysetPage(2))
+				require.NoError(t, err)
+				require.Len(t, first, 2)
+
+				added := identity.NewIdentity(defaultSchema.ID)
+				require.NoError(t, p.CreateIdentity(ctx, added))
+				t.Cleanup(func() {
+					require.NoError(t, p.DeleteIdentity(ctx, added.ID))
+				})
+
+				listed := []uuid.UUID{first[0].ID, first[1].ID}
+				page := x.KeysetPage(2).Next(first[1].ID)
+				for {
+					is, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, page)
+					require.NoError(t, err)
+					if len(is) == 0 {
+						break
+					}
+					for _, i := range is {
+						if i.ID != added.ID {
+							listed = append(listed, i.ID)
+						}
+					}
+					page = page.Next(is[len(is)-1].ID)
+				}
+
+				// Every identity is listed exactly once, regardless of where the added identity sorts.
+				assert.ElementsMatch(t, created, listed)
+			})
+
+			t.Run("offset", func(t *testing.T) {
+				var listed []uuid.UUID
+				// Offset pages start at 1 because page 0 is treated as the first page as well.
+				for k := 1; k <= 3; k++ {
+					is, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{}, x.OffsetPage(k, 2))
+					require.NoError(t, err)
+					for _, i := range is {
+						listed = append(listed, i.ID)
+					}
+				}
+				assert.ElementsMatch(t, created, listed)
+			})
+		})
+
+		t.Run("case=list with filter", func(t *testing.T) {
+			_, p := testhelpers.NewNetwork(t, ctx, p)
+			now := time.Now().UTC().Truncate(time.Second)
+
+			create := func(t *testing.T, schemaID string, state identity.State, createdAt time.Time, traits string, ct identity.CredentialsType, ids ...string) *identity.Identity {
+				i := identity.NewIdentity(schemaID)
+				i.State = state
+				i.CreatedAt = createdAt
+				i.Traits = identity.Traits(traits)
+				i.SetCredentials(ct, identity.Credentials{
+					Type: ct, Identifiers: ids,
+					Config: sqlxx.JSONRawMessage(`{}`),
+				})
+				require.NoError(t, p.CreateIdentity(ctx, i))
+				return i
+			}
+
+			alice := create(t, "", identity.StateActive, now.Add(-time.Hour*48), `{"email":"Alice@Example.org","name":{"first":"Alice"},"age":30,"newsletter":true}`, identity.CredentialsTypePassword, "Alice@Example.org")
+			bob := create(t, altSchema.ID, identity.StateInactive, now.Add(-time.Hour*24), `{"bar":"baz","name":{"first":"Bob"},"age":31,"newsletter":false}`, identity.CredentialsTypeOIDC, "bob_100%!", "Bob-Upper")
+			carol := create(t, altSchema.ID, identity.StateActive, now, `{"bar":"baz","name":{"first":"Carol"}}`, identity.CredentialsTypeOIDC, "bobby-100-x")
+
+			for k, tc := range []struct {
+				d        string
+				filter   identity.ListIdentitiesFilter
+				expected []*identity.Identity
+			}{
+				{d: "no filter", expected: []*identity.Identity{alice, bob, carol}},
+				{d: "exact password identifier with mixed case input", filter: identity.ListIdentitiesFilter{CredentialsIdentifier: "ALICE@example.ORG"}, expected: []*identity.Identity{alice}},
+				{d: "exact oidc identifier", filter: identity.ListIdentitiesFilter{CredentialsIdentifier: "Bob-Upper"}, expected: []*identity.Identity{bob}},
+				{d: "exact identifier does not match prefix", filter: identity.ListIdentitiesFilter{CredentialsIdentifier: "alice"}},
+				{d: "prefix", filter: identity.ListIdentitiesFilter{CredentialsIdentifierPrefix: "bob"}, expected: []*identity.Identity{bob, carol}},
+				{d: "prefix with escaped underscore", filter: identity.ListIdentitiesFilter{CredentialsIdentifierPrefix: "bob_"}, expected: []*identity.Identity{bob}},
+				{d: "prefix with escaped percent and exclamation mark", filter: identity.ListIdentitiesFilter{CredentialsIdentifierPrefix: "bob_100%!"}, expected: []*identity.Identity{bob}},
+				{d: "prefix with percent is not a wildcard", filter: identity.ListIdentitiesFilter{CredentialsIdentifierPrefix: "%"}},
+				{d: "exact identifier and prefix are combined", filter: identity.ListIdentitiesFilter{CredentialsIdentifier: "bob-upper", CredentialsIdentifierPrefix: "bobby"}},
+				{d: "traits email", filter: identity.ListIdentitiesFilter{Traits: map[string]string{"email": "Alice@Example.org"}}, expected: []*identity.Identity{alice}},
+				{d: "nested traits", filter: identity.ListIdentitiesFilter{Traits: map[string]string{"name.first": "Carol"}}, expected: []*identity.Identity{carol}},
+				{d: "numeric traits", filter: identity.ListIdentitiesFilter{Traits: map[string]string{"age": "31"}}, expected: []*identity.Identity{bob}},
+				{d: "boolean traits", filter: identity.ListIdentitiesFilter{Traits: map[string]string{"newsletter": "true"}}, expected: []*identity.Identity{alice}},
+				{d: "missing traits", filter: identity.ListIdentitiesFilter{Traits: map[string]string{"does_not_exist": "Carol"}}},
+				{d: "state", filter: identity.ListIdentitiesFilter{State: identity.StateInactive}, expected: []*identity.Identity{bob}},
+				{d: "schema id", filter: identity.ListIdentitiesFilter{SchemaID: altSchema.ID}, expected: []*identity.Identity{bob, carol}},
+				{d: "created after", filter: identity.ListIdentitiesFilter{CreatedAfter: now.Add(-time.Hour * 24)}, expected: []*identity.Identity{bob, carol}},
+				{d: "created before", filter: identity.ListIdentitiesFilter{CreatedBefore: now.Add(-time.Hour * 24)}, expected: []*identity.Identity{alice, bob}},
+				{d: "created between", filter: identity.ListIdentitiesFilter{CreatedAfter: now.Add(-time.Hour * 30), CreatedBefore: now.Add(-time.Hour)}, expected: []*identity.Identity{bob}},
+				{d: "combined with and", filter: identity.ListIdentitiesFilter{SchemaID: altSchema.ID, State: identity.StateActive, Traits: map[string]string{"bar": "baz"}}, expected: []*identity.Identity{carol}},
+				{d: "combined with and without match", filter: identity.ListIdentitiesFilter{SchemaID: altSchema.ID, Traits: map[string]string{"name.first": "Alice"}}},
+			} {
+				t.Run(fmt.Sprintf("case=%d/description=%s", k, tc.d), func(t *testing.T) {
+					is, err := p.ListIdentities(ctx, tc.filter, x.KeysetPage(25))
+					require.NoError(t, err)
+
+					actual := make([]uuid.UUID, len(is))
+					for k := range is {
+						actual[k] = is[k].ID
+					}
+					expected := make([]uuid.UUID, len(tc.expected))
+					for k := range tc.expected {
+						expected[k] = tc.expected[k].ID
+					}
+					assert.ElementsMatch(t, expected, actual)
+
+					count, err := p.CountIdentities(ctx, tc.filter)
+					require.NoError(t, err)
+					assert.EqualValues(t, len(tc.expected), count)
+
+					t.Run("no results on other network", func(t *testing.T) {
+						_, p := testhelpers.NewNetwork(t, ctx, p)
+						is, err := p.ListIdentities(ctx, tc.filter, x.KeysetPage(25))
+						require.NoError(t, err)
+						assert.Len(t, is, 0)
+
+						count, err := p.CountIdentities(ctx, tc.filter)
+						require.NoError(t, err)
+						assert.EqualValues(t, 0, count)
+					})
+				})
+			}
+
+			t.Run("case=invalid traits path", func(t *testing.T) {
+				for _, path := range []string{"a;drop", "0foo", "123", "a..b", "a.1", "a-b", ""} {
+					_, err := p.ListIdentities(ctx, identity.ListIdentitiesFilter{Traits: map[string]string{path: "x"}}, x.KeysetPage(25))
+					require.ErrorIs(t, err, herodot.ErrBadRequest, path)
+
+					_, err = p.CountIdentities(ctx, identity.ListIdentitiesFilter{Traits: map[string]string{path: "x"}})
+					require.ErrorIs(t, err, herodot.ErrBadRequest, path)
+				}
 			})
 		})
 
@@ -467,6 +834,101 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 			})
 		})
 
+		t.Run("case=find identity by its external ID", func(t *testing.T) {
+			externalID := x.NewUUID().String()
+			expected := identity.NewIdentity("")
+			expected.ExternalID = sqlxx.NullString(externalID)
+			require.NoError(t, p.CreateIdentity(ctx, expected))
+			createdIDs = append(createdIDs, expected.ID)
+
+			actual, err := p.FindIdentityByExternalID(ctx, externalID)
+			require.NoError(t, err)
+			assert.Equal(t, expected.ID, actual.ID)
+			assert.Equal(t, externalID, actual.ExternalID.String())
+
+			_, err = p.FindIdentityByExternalID(ctx, x.NewUUID().String())
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+			t.Run("fails on duplicate external ID", func(t *testing.T) {
+				duplicate := identity.NewIdentity("")
+				duplicate.ExternalID = sqlxx.NullString(externalID)
+				require.ErrorIs(t, p.CreateIdentity(ctx, duplicate), sqlcon.ErrUniqueViolation)
+
+				second := identity.NewIdentity("")
+				require.NoError(t, p.CreateIdentity(ctx, second))
+				createdIDs = append(createdIDs, second.ID)
+
+				second.ExternalID = sqlxx.NullString(externalID)
+				require.ErrorIs(t, p.UpdateIdentity(ctx, second), sqlcon.ErrUniqueViolation)
+			})
+
+			t.Run("not if on another network", func(t *testing.T) {
+				_, p := testhelpers.NewNetwork(t, ctx, p)
+				_, err := p.FindIdentityByExternalID(ctx, externalID)
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+				other := identity.NewIdentity("")
+				other.ExternalID = sqlxx.NullString(externalID)
+				require.NoError(t, p.CreateIdentity(ctx, other))
+			})
+
+			t.Run("not after removing the external ID", func(t *testing.T) {
+				actual, err := p.GetIdentityConfidential(ctx, expected.ID)
+				require.NoError(t, err)
+				actual.ExternalID = ""
+				require.NoError(t, p.UpdateIdentity(ctx, actual))
+
+				_, err = p.FindIdentityByExternalID(ctx, externalID)
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+		})
+
+		t.Run("case=find identity by its unique trait", func(t *testing.T) {
+			username := x.NewUUID().String()
+			expected := identity.NewIdentity(uniqueSchema.ID)
+			expected.Traits = identity.Traits(`{"username":"` + username + `"}`)
+			require.NoError(t, p.CreateIdentity(ctx, expected))
+			createdIDs = append(createdIDs, expected.ID)
+
+			actual, err := p.FindIdentityIDByUniqueTrait(ctx, "traits.username", username)
+			require.NoError(t, err)
+			assert.Equal(t, expected.ID, actual)
+
+			_, err = p.FindIdentityIDByUniqueTrait(ctx, "traits.username", x.NewUUID().String())
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+			t.Run("fails on duplicate value", func(t *testing.T) {
+				duplicate := identity.NewIdentity(uniqueSchema.ID)
+				duplicate.Traits = identity.Traits(`{"username":"` + username + `"}`)
+				require.ErrorIs(t, p.CreateIdentity(ctx, duplicate), sqlcon.ErrUniqueViolation)
+			})
+
+			t.Run("not if on another network", func(t *testing.T) {
+				_, p := testhelpers.NewNetwork(t, ctx, p)
+				_, err := p.FindIdentityIDByUniqueTrait(ctx, "traits.username", username)
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+				other := identity.NewIdentity(uniqueSchema.ID)
+				other.Traits = identity.Traits(`{"username":"` + username + `"}`)
+				require.NoError(t, p.CreateIdentity(ctx, other))
+			})
+
+			t.Run("not after changing the value", func(t *testing.T) {
+				actual, err := p.GetIdentityConfidential(ctx, expected.ID)
+				require.NoError(t, err)
+				actual.Traits = identity.Traits(`{"username":"` + x.NewUUID().String() + `"}`)
+				require.NoError(t, p.UpdateIdentity(ctx, actual))
+
+				_, err = p.FindIdentityIDByUniqueTrait(ctx, "traits.username", username)
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+				reused := identity.NewIdentity(uniqueSchema.ID)
+				reused.Traits = identity.Traits(`{"username":"` + username + `"}`)
+				require.NoError(t, p.CreateIdentity(ctx, reused))
+				createdIDs = append(createdIDs, reused.ID)
+			})
+		})
+
 		t.Run("suite=verifiable-address", func(t *testing.T) {
 			createIdentityWithAddresses := func(t *testing.T, email string) identity.VerifiableAddress {
 				var i identity.Identity
@@ -532,6 +994,46 @@ func TestPool(ctx context.Context, conf *config.Config, p interface {
 				assert.Equal(t, "new-code", actual.Value)
 			})
 
+			t.Run("case=get by id", func(t *testing.T) {
+				address := createIdentityWithAddresses(t, "verification.TestPersister.Get@ory.sh")
+
+				actual, err := p.GetVerifiableAddress(ctx, address.ID)
+				require.NoError(t, err)
+				assert.Equal(t, address.Value, actual.Value)
+				assert.Equal(t, address.IdentityID, actual.IdentityID)
+
+				t.Run("not if on another network", func(t *testing.T) {
+					_, p := testhelpers.NewNetwork(t, ctx, p)
+					_, err := p.GetVerifiableAddress(ctx, address.ID)
+					require.ErrorIs(t, err, sqlcon.ErrNoRows)
+				})
+
+				_, err = p.GetVerifiableAddress(ctx, x.NewUUID())
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
+			t.Run("case=list by status", func(t *testing.T) {
+				address := createIdentityWithAddresses(t, "verification.TestPersister.ListByStatus@ory.sh")
+				address.Status = identity.VerifiableAddressStatusSent
+				require.NoError(t, p.UpdateVerifiableAddress(ctx, &address))
+
+				actual, err := p.ListVerifiableAddresses(ctx, identity.ListVerifiableAddressesFilter{Status: identity.VerifiableAddressStatusSent}, x.KeysetPage(1000))
+				require.NoError(t, err)
+				require.NotEmpty(t, actual)
+				var found bool
+				for _, a := range actual {
+					assert.Equal(t, identity.VerifiableAddressStatusSent, a.Status)
+					found = found || a.ID == address.ID
+				}
+				assert.True(t, found)
+
+				actual, err = p.ListVerifiableAddresses(ctx, identity.ListVerifiableAddressesFilter{Status: identity.VerifiableAddressStatusCompleted}, x.KeysetPage(1000))
+				require.NoError(t, err)
+				for _, a := range actual {
+					assert.NotEqual(t, address.ID, a.ID)
+				}
+			})
+
 			t.Run("case=create and update and find", func(t *testing.T) {
 				var i identity.Identity
 				require.NoError(t, faker.FakeData(&i))
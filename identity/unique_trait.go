@@ -0,0 +1,51 @@
+package identity
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/kratos/corp"
+)
+
+// maxUniqueTraitLength is the size of the `value` column.
+const maxUniqueTraitLength = 255
+
+// UniqueTrait is the value of a trait which is marked as unique in the identity schema:
+//
+//	"username": {
+//	  "type": "string",
+//	  "ory.sh/kratos": {
+//	    "unique": true
+//	  }
+//	}
+//
+// No two identities of a network may have the same value at the same path. If the trait is an array, each of
+// its items is unique.
+//
+// swagger:ignore
+type UniqueTrait struct {
+	ID         uuid.UUID `json:"-" db:"id"`
+	IdentityID uuid.UUID `json:"-" db:"identity_id"`
+
+	// Path is the path of the trait, e.g. `traits.username`.
+	Path string `json:"path" db:"path"`
+
+	// Value is the value of the trait. Numbers are stored in their JSON representation.
+	Value string `json:"value" db:"value"`
+
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+	NID       uuid.UUID `json:"-" faker:"-" db:"nid"`
+}
+
+func (t UniqueTrait) TableName(ctx context.Context) string {
+	return corp.ContextualizeTableName(ctx, "identity_unique_traits")
+}
+
+// InstancePtr returns the JSON pointer of the trait within the identity, e.g. `#/traits/username`.
+func (t UniqueTrait) InstancePtr() string {
+	return "#/" + strings.ReplaceAll(t.Path, ".", "/")
+}
@@ -2,9 +2,18 @@ package identity
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
+	"github.com/ory/herodot"
+	"github.com/ory/jsonschema/v3"
+	"github.com/ory/x/jsonschemax"
+	"github.com/ory/x/sqlcon"
+
 	"github.com/ory/kratos/driver/config"
 	"github.com/ory/kratos/schema"
 )
@@ -12,6 +21,7 @@ import (
 type (
 	validatorDependencies interface {
 		IdentityTraitsSchemas(ctx context.Context) schema.Schemas
+		PrivilegedPoolProvider
 		config.Provider
 	}
 	Validator struct {
@@ -47,9 +57,104 @@ func (v *Validator) ValidateWithRunner(ctx context.Context, i *Identity, runners
 }
 
 func (v *Validator) Validate(ctx context.Context, i *Identity) error {
-	return v.ValidateWithRunner(ctx, i,
+	if err := v.ValidateWithRunner(ctx, i,
 		NewSchemaExtensionCredentials(i),
 		NewSchemaExtensionVerification(i, v.d.Config(ctx).SelfServiceFlowVerificationRequestLifespan()),
 		NewSchemaExtensionRecovery(i),
-	)
+	); err != nil {
+		return err
+	}
+
+	return v.validateUniqueTraits(ctx, i)
+}
+
+// UniqueTraits returns the values of the identity's traits which are marked as unique in its identity schema.
+func (v *Validator) UniqueTraits(ctx context.Context, i *Identity) ([]UniqueTrait, error) {
+	s, err := v.d.IdentityTraitsSchemas(ctx).GetByID(i.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+
+	runner, err := schema.NewExtensionRunner()
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	runner.Register(compiler)
+	paths, err := jsonschemax.ListPaths(s.URL.String(), compiler)
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrInternalServerError.WithReasonf("Unable to list the paths of the identity schema.").WithDebugf("%s", err))
+	}
+
+	var traits []UniqueTrait
+	for _, path := range paths {
+		if unique, _ := path.CustomProperties[schema.ExtensionUnique].(bool); !unique || !strings.HasPrefix(path.Name, "traits.") {
+			continue
+		}
+
+		value := gjson.GetBytes(i.Traits, strings.TrimPrefix(path.Name, "traits."))
+		values := []gjson.Result{value}
+		if value.IsArray() {
+			values = value.Array()
+		}
+
+		for _, value := range values {
+			var raw string
+			switch value.Type {
+			case gjson.String:
+				raw = value.String()
+			case gjson.Number:
+				raw = value.Raw
+			default:
+				continue
+			}
+
+			if len(raw) == 0 || hasUniqueTrait(traits, path.Name, raw) {
+				continue
+			}
+			traits = append(traits, UniqueTrait{IdentityID: i.ID, Path: path.Name, Value: raw})
+		}
+	}
+
+	return traits, nil
+}
+
+func hasUniqueTrait(traits []UniqueTrait, path, value string) bool {
+	for _, t := range traits {
+		if t.Path == path && t.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUniqueTraits makes sure that no other identity has the values of the identity's unique traits.
+func (v *Validator) validateUniqueTraits(ctx context.Context, i *Identity) error {
+	traits, err := v.UniqueTraits(ctx, i)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range traits {
+		if len(t.Value) > maxUniqueTraitLength {
+			return errors.WithStack(&jsonschema.ValidationError{
+				Message:     fmt.Sprintf("length must be <= %d, but got %d", maxUniqueTraitLength, len(t.Value)),
+				InstancePtr: t.InstancePtr(),
+			})
+		}
+
+		id, err := v.d.PrivilegedIdentityPool().FindIdentityIDByUniqueTrait(ctx, t.Path, t.Value)
+		if errors.Is(err, sqlcon.ErrNoRows) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if id != i.ID {
+			return schema.NewDuplicateTraitError(t.InstancePtr(), t.Value)
+		}
+	}
+
+	return nil
 }
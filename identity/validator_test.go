@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ory/kratos/driver/config"
 	. "github.com/ory/kratos/identity"
 	"github.com/ory/kratos/internal"
+	"github.com/ory/kratos/schema"
+	"github.com/ory/kratos/text"
 )
 
 func TestSchemaValidator(t *testing.T) {
@@ -115,3 +119,62 @@ func TestSchemaValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestUniqueTraits(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	conf.MustSet(config.ViperKeyDefaultIdentitySchemaURL, "file://./stub/unique.schema.json")
+	v := reg.IdentityValidator()
+
+	existing := NewIdentity(config.DefaultIdentityTraitsSchemaID)
+	existing.Traits = Traits(`{"username":"foo","employee_number":4711,"aliases":["bar","baz"],"nickname":"nick"}`)
+
+	t.Run("case=lists the unique traits", func(t *testing.T) {
+		traits, err := v.UniqueTraits(context.Background(), existing)
+		require.NoError(t, err)
+
+		actual := make([]string, len(traits))
+		for k, trait := range traits {
+			actual[k] = trait.Path + "=" + trait.Value
+		}
+		assert.ElementsMatch(t, []string{"traits.username=foo", "traits.employee_number=4711", "traits.aliases=bar", "traits.aliases=baz"}, actual)
+	})
+
+	require.NoError(t, reg.IdentityManager().Create(context.Background(), existing))
+
+	for k, tc := range []struct {
+		traits string
+		ptr    string
+	}{
+		{traits: `{"username":"foo"}`, ptr: "#/traits/username"},
+		{traits: `{"employee_number":4711}`, ptr: "#/traits/employee_number"},
+		{traits: `{"aliases":["qux","baz"]}`, ptr: "#/traits/aliases"},
+		{traits: `{"username":"bar","nickname":"nick"}`},
+		{traits: `{"username":"foo-2","employee_number":4712,"aliases":["qux"]}`},
+	} {
+		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
+			i := NewIdentity(config.DefaultIdentityTraitsSchemaID)
+			i.Traits = Traits(tc.traits)
+
+			err := v.Validate(context.Background(), i)
+			if tc.ptr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			var e *schema.ValidationError
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, tc.ptr, e.InstancePtr)
+			assert.Equal(t, text.ErrorValidationDuplicateTrait, e.Messages[0].ID)
+		})
+	}
+
+	t.Run("case=ignores the identity itself", func(t *testing.T) {
+		require.NoError(t, v.Validate(context.Background(), existing))
+	})
+
+	t.Run("case=fails if the value is too long", func(t *testing.T) {
+		i := NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = Traits(`{"username":"` + strings.Repeat("a", 256) + `"}`)
+		require.Error(t, v.Validate(context.Background(), i))
+	})
+}
@@ -17,6 +17,13 @@ import (
 
 // AdminCreateIdentityBody struct for AdminCreateIdentityBody
 type AdminCreateIdentityBody struct {
+	Credentials *AdminIdentityImportCredentials `json:"credentials,omitempty"`
+	// ExternalID is a unique identifier of the identity in another system, e.g. the ID of the customer in a billing system. The identity can be fetched using `GET /identities/by/external_id/{external_id}`.
+	ExternalId *string `json:"external_id,omitempty"`
+	// Store metadata about the user which is only accessible through admin APIs such as `GET /admin/identities/<id>`.
+	MetadataAdmin map[string]interface{} `json:"metadata_admin,omitempty"`
+	// Store metadata about the identity which the identity itself can see when calling for example the session endpoint. Do not store sensitive information (e.g. credit score) about the identity in this field.
+	MetadataPublic map[string]interface{} `json:"metadata_public,omitempty"`
 	// SchemaID is the ID of the JSON Schema to be used for validating the identity's traits.
 	SchemaId string         `json:"schema_id"`
 	State    *IdentityState `json:"state,omitempty"`
@@ -43,6 +50,134 @@ func NewAdminCreateIdentityBodyWithDefaults() *AdminCreateIdentityBody {
 	return &this
 }
 
+// GetCredentials returns the Credentials field value if set, zero value otherwise.
+func (o *AdminCreateIdentityBody) GetCredentials() AdminIdentityImportCredentials {
+	if o == nil || o.Credentials == nil {
+		var ret AdminIdentityImportCredentials
+		return ret
+	}
+	return *o.Credentials
+}
+
+// GetCredentialsOk returns a tuple with the Credentials field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminCreateIdentityBody) GetCredentialsOk() (*AdminIdentityImportCredentials, bool) {
+	if o == nil || o.Credentials == nil {
+		return nil, false
+	}
+	return o.Credentials, true
+}
+
+// HasCredentials returns a boolean if a field has been set.
+func (o *AdminCreateIdentityBody) HasCredentials() bool {
+	if o != nil && o.Credentials != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCredentials gets a reference to the given AdminIdentityImportCredentials and assigns it to the Credentials field.
+func (o *AdminCreateIdentityBody) SetCredentials(v AdminIdentityImportCredentials) {
+	o.Credentials = &v
+}
+
+// GetExternalId returns the ExternalId field value if set, zero value otherwise.
+func (o *AdminCreateIdentityBody) GetExternalId() string {
+	if o == nil || o.ExternalId == nil {
+		var ret string
+		return ret
+	}
+	return *o.ExternalId
+}
+
+// GetExternalIdOk returns a tuple with the ExternalId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminCreateIdentityBody) GetExternalIdOk() (*string, bool) {
+	if o == nil || o.ExternalId == nil {
+		return nil, false
+	}
+	return o.ExternalId, true
+}
+
+// HasExternalId returns a boolean if a field has been set.
+func (o *AdminCreateIdentityBody) HasExternalId() bool {
+	if o != nil && o.ExternalId != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExternalId gets a reference to the given string and assigns it to the ExternalId field.
+func (o *AdminCreateIdentityBody) SetExternalId(v string) {
+	o.ExternalId = &v
+}
+
+// GetMetadataAdmin returns the MetadataAdmin field value if set, zero value otherwise.
+func (o *AdminCreateIdentityBody) GetMetadataAdmin() map[string]interface{} {
+	if o == nil || o.MetadataAdmin == nil {
+		var ret map[string]interface{}
+		return ret
+	}
+	return o.MetadataAdmin
+}
+
+// GetMetadataAdminOk returns a tuple with the MetadataAdmin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminCreateIdentityBody) GetMetadataAdminOk() (map[string]interface{}, bool) {
+	if o == nil || o.MetadataAdmin == nil {
+		return nil, false
+	}
+	return o.MetadataAdmin, true
+}
+
+// HasMetadataAdmin returns a boolean if a field has been set.
+func (o *AdminCreateIdentityBody) HasMetadataAdmin() bool {
+	if o != nil && o.MetadataAdmin != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadataAdmin gets a reference to the given map[string]interface{} and assigns it to the MetadataAdmin field.
+func (o *AdminCreateIdentityBody) SetMetadataAdmin(v map[string]interface{}) {
+	o.MetadataAdmin = v
+}
+
+// GetMetadataPublic returns the MetadataPublic field value if set, zero value otherwise.
+func (o *AdminCreateIdentityBody) GetMetadataPublic() map[string]interface{} {
+	if o == nil || o.MetadataPublic == nil {
+		var ret map[string]interface{}
+		return ret
+	}
+	return o.MetadataPublic
+}
+
+// GetMetadataPublicOk returns a tuple with the MetadataPublic field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminCreateIdentityBody) GetMetadataPublicOk() (map[string]interface{}, bool) {
+	if o == nil || o.MetadataPublic == nil {
+		return nil, false
+	}
+	return o.MetadataPublic, true
+}
+
+// HasMetadataPublic returns a boolean if a field has been set.
+func (o *AdminCreateIdentityBody) HasMetadataPublic() bool {
+	if o != nil && o.MetadataPublic != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadataPublic gets a reference to the given map[string]interface{} and assigns it to the MetadataPublic field.
+func (o *AdminCreateIdentityBody) SetMetadataPublic(v map[string]interface{}) {
+	o.MetadataPublic = v
+}
+
 // GetSchemaId returns the SchemaId field value
 func (o *AdminCreateIdentityBody) GetSchemaId() string {
 	if o == nil {
@@ -125,6 +260,18 @@ func (o *AdminCreateIdentityBody) SetTraits(v map[string]interface{}) {
 
 func (o AdminCreateIdentityBody) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
+	if o.Credentials != nil {
+		toSerialize["credentials"] = o.Credentials
+	}
+	if o.ExternalId != nil {
+		toSerialize["external_id"] = o.ExternalId
+	}
+	if o.MetadataAdmin != nil {
+		toSerialize["metadata_admin"] = o.MetadataAdmin
+	}
+	if o.MetadataPublic != nil {
+		toSerialize["metadata_public"] = o.MetadataPublic
+	}
 	if true {
 		toSerialize["schema_id"] = o.SchemaId
 	}
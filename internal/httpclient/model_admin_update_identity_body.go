@@ -17,6 +17,12 @@ import (
 
 // AdminUpdateIdentityBody struct for AdminUpdateIdentityBody
 type AdminUpdateIdentityBody struct {
+	// ExternalID is a unique identifier of the identity in another system, e.g. the ID of the customer in a billing system.  The external ID is replaced with this value. If omitted, the external ID is removed.
+	ExternalId *string `json:"external_id,omitempty"`
+	// Store metadata about the user which is only accessible through admin APIs such as `GET /admin/identities/<id>`.  The metadata is replaced with this value. If omitted, the metadata is removed.
+	MetadataAdmin map[string]interface{} `json:"metadata_admin,omitempty"`
+	// Store metadata about the identity which the identity itself can see when calling for example the session endpoint. Do not store sensitive information (e.g. credit score) about the identity in this field.  The metadata is replaced with this value. If omitted, the metadata is removed.
+	MetadataPublic map[string]interface{} `json:"metadata_public,omitempty"`
 	// SchemaID is the ID of the JSON Schema to be used for validating the identity's traits. If set will update the Identity's SchemaID.
 	SchemaId *string       `json:"schema_id,omitempty"`
 	State    IdentityState `json:"state"`
@@ -43,6 +49,102 @@ func NewAdminUpdateIdentityBodyWithDefaults() *AdminUpdateIdentityBody {
 	return &this
 }
 
+// GetExternalId returns the ExternalId field value if set, zero value otherwise.
+func (o *AdminUpdateIdentityBody) GetExternalId() string {
+	if o == nil || o.ExternalId == nil {
+		var ret string
+		return ret
+	}
+	return *o.ExternalId
+}
+
+// GetExternalIdOk returns a tuple with the ExternalId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminUpdateIdentityBody) GetExternalIdOk() (*string, bool) {
+	if o == nil || o.ExternalId == nil {
+		return nil, false
+	}
+	return o.ExternalId, true
+}
+
+// HasExternalId returns a boolean if a field has been set.
+func (o *AdminUpdateIdentityBody) HasExternalId() bool {
+	if o != nil && o.ExternalId != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExternalId gets a reference to the given string and assigns it to the ExternalId field.
+func (o *AdminUpdateIdentityBody) SetExternalId(v string) {
+	o.ExternalId = &v
+}
+
+// GetMetadataAdmin returns the MetadataAdmin field value if set, zero value otherwise.
+func (o *AdminUpdateIdentityBody) GetMetadataAdmin() map[string]interface{} {
+	if o == nil || o.MetadataAdmin == nil {
+		var ret map[string]interface{}
+		return ret
+	}
+	return o.MetadataAdmin
+}
+
+// GetMetadataAdminOk returns a tuple with the MetadataAdmin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminUpdateIdentityBody) GetMetadataAdminOk() (map[string]interface{}, bool) {
+	if o == nil || o.MetadataAdmin == nil {
+		return nil, false
+	}
+	return o.MetadataAdmin, true
+}
+
+// HasMetadataAdmin returns a boolean if a field has been set.
+func (o *AdminUpdateIdentityBody) HasMetadataAdmin() bool {
+	if o != nil && o.MetadataAdmin != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadataAdmin gets a reference to the given map[string]interface{} and assigns it to the MetadataAdmin field.
+func (o *AdminUpdateIdentityBody) SetMetadataAdmin(v map[string]interface{}) {
+	o.MetadataAdmin = v
+}
+
+// GetMetadataPublic returns the MetadataPublic field value if set, zero value otherwise.
+func (o *AdminUpdateIdentityBody) GetMetadataPublic() map[string]interface{} {
+	if o == nil || o.MetadataPublic == nil {
+		var ret map[string]interface{}
+		return ret
+	}
+	return o.MetadataPublic
+}
+
+// GetMetadataPublicOk returns a tuple with the MetadataPublic field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *AdminUpdateIdentityBody) GetMetadataPublicOk() (map[string]interface{}, bool) {
+	if o == nil || o.MetadataPublic == nil {
+		return nil, false
+	}
+	return o.MetadataPublic, true
+}
+
+// HasMetadataPublic returns a boolean if a field has been set.
+func (o *AdminUpdateIdentityBody) HasMetadataPublic() bool {
+	if o != nil && o.MetadataPublic != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadataPublic gets a reference to the given map[string]interface{} and assigns it to the MetadataPublic field.
+func (o *AdminUpdateIdentityBody) SetMetadataPublic(v map[string]interface{}) {
+	o.MetadataPublic = v
+}
+
 // GetSchemaId returns the SchemaId field value if set, zero value otherwise.
 func (o *AdminUpdateIdentityBody) GetSchemaId() string {
 	if o == nil || o.SchemaId == nil {
@@ -125,6 +227,15 @@ func (o *AdminUpdateIdentityBody) SetTraits(v map[string]interface{}) {
 
 func (o AdminUpdateIdentityBody) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
+	if o.ExternalId != nil {
+		toSerialize["external_id"] = o.ExternalId
+	}
+	if o.MetadataAdmin != nil {
+		toSerialize["metadata_admin"] = o.MetadataAdmin
+	}
+	if o.MetadataPublic != nil {
+		toSerialize["metadata_public"] = o.MetadataPublic
+	}
 	if o.SchemaId != nil {
 		toSerialize["schema_id"] = o.SchemaId
 	}
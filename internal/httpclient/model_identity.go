@@ -22,7 +22,14 @@ type Identity struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// Credentials represents all credentials that can be used for authenticating this identity.
 	Credentials *map[string]IdentityCredentials `json:"credentials,omitempty"`
-	Id          string                          `json:"id"`
+	// ExternalID is a unique identifier of the identity in another system, e.g. the ID of the customer in a billing system. It can only be set using the admin API.
+	ExternalId *string `json:"external_id,omitempty"`
+	Id         string  `json:"id"`
+	// NullJSONRawMessage represents a json.RawMessage that works well with JSON, SQL, and Swagger and is NULLable-
+	MetadataAdmin interface{} `json:"metadata_admin,omitempty"`
+	// NullJSONRawMessage represents a json.RawMessage that works well with JSON, SQL, and Swagger and is NULLable-
+	MetadataPublic interface{} `json:"metadata_public,omitempty"`
+	ReactivateAt   *time.Time  `json:"reactivate_at,omitempty"`
 	// RecoveryAddresses contains all the addresses that can be used to recover an identity.
 	RecoveryAddresses []RecoveryAddress `json:"recovery_addresses,omitempty"`
 	// SchemaID is the ID of the JSON Schema to be used for validating the identity's traits.
@@ -31,6 +38,8 @@ type Identity struct {
 	SchemaUrl      string         `json:"schema_url"`
 	State          *IdentityState `json:"state,omitempty"`
 	StateChangedAt *time.Time     `json:"state_changed_at,omitempty"`
+	// StateReason is the reason given when the identity was deactivated.
+	StateReason *string `json:"state_reason,omitempty"`
 	// Traits represent an identity's traits. The identity is able to create, modify, and delete traits in a self-service manner. The input will always be validated against the JSON Schema defined in `schema_url`.
 	Traits interface{} `json:"traits"`
 	// UpdatedAt is a helper struct field for gobuffalo.pop.
@@ -124,6 +133,38 @@ func (o *Identity) SetCredentials(v map[string]IdentityCredentials) {
 	o.Credentials = &v
 }
 
+// GetExternalId returns the ExternalId field value if set, zero value otherwise.
+func (o *Identity) GetExternalId() string {
+	if o == nil || o.ExternalId == nil {
+		var ret string
+		return ret
+	}
+	return *o.ExternalId
+}
+
+// GetExternalIdOk returns a tuple with the ExternalId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Identity) GetExternalIdOk() (*string, bool) {
+	if o == nil || o.ExternalId == nil {
+		return nil, false
+	}
+	return o.ExternalId, true
+}
+
+// HasExternalId returns a boolean if a field has been set.
+func (o *Identity) HasExternalId() bool {
+	if o != nil && o.ExternalId != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExternalId gets a reference to the given string and assigns it to the ExternalId field.
+func (o *Identity) SetExternalId(v string) {
+	o.ExternalId = &v
+}
+
 // GetId returns the Id field value
 func (o *Identity) GetId() string {
 	if o == nil {
@@ -148,6 +189,104 @@ func (o *Identity) SetId(v string) {
 	o.Id = v
 }
 
+// GetMetadataAdmin returns the MetadataAdmin field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *Identity) GetMetadataAdmin() interface{} {
+	if o == nil {
+		var ret interface{}
+		return ret
+	}
+	return o.MetadataAdmin
+}
+
+// GetMetadataAdminOk returns a tuple with the MetadataAdmin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *Identity) GetMetadataAdminOk() (*interface{}, bool) {
+	if o == nil || o.MetadataAdmin == nil {
+		return nil, false
+	}
+	return &o.MetadataAdmin, true
+}
+
+// HasMetadataAdmin returns a boolean if a field has been set.
+func (o *Identity) HasMetadataAdmin() bool {
+	if o != nil && o.MetadataAdmin != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadataAdmin gets a reference to the given interface{} and assigns it to the MetadataAdmin field.
+func (o *Identity) SetMetadataAdmin(v interface{}) {
+	o.MetadataAdmin = v
+}
+
+// GetMetadataPublic returns the MetadataPublic field value if set, zero value otherwise (both if not set or set to explicit null).
+func (o *Identity) GetMetadataPublic() interface{} {
+	if o == nil {
+		var ret interface{}
+		return ret
+	}
+	return o.MetadataPublic
+}
+
+// GetMetadataPublicOk returns a tuple with the MetadataPublic field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+// NOTE: If the value is an explicit nil, `nil, true` will be returned
+func (o *Identity) GetMetadataPublicOk() (*interface{}, bool) {
+	if o == nil || o.MetadataPublic == nil {
+		return nil, false
+	}
+	return &o.MetadataPublic, true
+}
+
+// HasMetadataPublic returns a boolean if a field has been set.
+func (o *Identity) HasMetadataPublic() bool {
+	if o != nil && o.MetadataPublic != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetMetadataPublic gets a reference to the given interface{} and assigns it to the MetadataPublic field.
+func (o *Identity) SetMetadataPublic(v interface{}) {
+	o.MetadataPublic = v
+}
+
+// GetReactivateAt returns the ReactivateAt field value if set, zero value otherwise.
+func (o *Identity) GetReactivateAt() time.Time {
+	if o == nil || o.ReactivateAt == nil {
+		var ret time.Time
+		return ret
+	}
+	return *o.ReactivateAt
+}
+
+// GetReactivateAtOk returns a tuple with the ReactivateAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Identity) GetReactivateAtOk() (*time.Time, bool) {
+	if o == nil || o.ReactivateAt == nil {
+		return nil, false
+	}
+	return o.ReactivateAt, true
+}
+
+// HasReactivateAt returns a boolean if a field has been set.
+func (o *Identity) HasReactivateAt() bool {
+	if o != nil && o.ReactivateAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetReactivateAt gets a reference to the given time.Time and assigns it to the ReactivateAt field.
+func (o *Identity) SetReactivateAt(v time.Time) {
+	o.ReactivateAt = &v
+}
+
 // GetRecoveryAddresses returns the RecoveryAddresses field value if set, zero value otherwise.
 func (o *Identity) GetRecoveryAddresses() []RecoveryAddress {
 	if o == nil || o.RecoveryAddresses == nil {
@@ -292,6 +431,38 @@ func (o *Identity) SetStateChangedAt(v time.Time) {
 	o.StateChangedAt = &v
 }
 
+// GetStateReason returns the StateReason field value if set, zero value otherwise.
+func (o *Identity) GetStateReason() string {
+	if o == nil || o.StateReason == nil {
+		var ret string
+		return ret
+	}
+	return *o.StateReason
+}
+
+// GetStateReasonOk returns a tuple with the StateReason field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Identity) GetStateReasonOk() (*string, bool) {
+	if o == nil || o.StateReason == nil {
+		return nil, false
+	}
+	return o.StateReason, true
+}
+
+// HasStateReason returns a boolean if a field has been set.
+func (o *Identity) HasStateReason() bool {
+	if o != nil && o.StateReason != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetStateReason gets a reference to the given string and assigns it to the StateReason field.
+func (o *Identity) SetStateReason(v string) {
+	o.StateReason = &v
+}
+
 // GetTraits returns the Traits field value
 // If the value is explicit nil, the zero value for interface{} will be returned
 func (o *Identity) GetTraits() interface{} {
@@ -390,9 +561,21 @@ func (o Identity) MarshalJSON() ([]byte, error) {
 	if o.Credentials != nil {
 		toSerialize["credentials"] = o.Credentials
 	}
+	if o.ExternalId != nil {
+		toSerialize["external_id"] = o.ExternalId
+	}
 	if true {
 		toSerialize["id"] = o.Id
 	}
+	if o.MetadataAdmin != nil {
+		toSerialize["metadata_admin"] = o.MetadataAdmin
+	}
+	if o.MetadataPublic != nil {
+		toSerialize["metadata_public"] = o.MetadataPublic
+	}
+	if o.ReactivateAt != nil {
+		toSerialize["reactivate_at"] = o.ReactivateAt
+	}
 	if o.RecoveryAddresses != nil {
 		toSerialize["recovery_addresses"] = o.RecoveryAddresses
 	}
@@ -408,6 +591,9 @@ func (o Identity) MarshalJSON() ([]byte, error) {
 	if o.StateChangedAt != nil {
 		toSerialize["state_changed_at"] = o.StateChangedAt
 	}
+	if o.StateReason != nil {
+		toSerialize["state_reason"] = o.StateReason
+	}
 	if o.Traits != nil {
 		toSerialize["traits"] = o.Traits
 	}
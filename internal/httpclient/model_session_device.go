@@ -13,11 +13,21 @@ package client
 
 import (
 	"encoding/json"
+	"time"
 )
 
-// SessionDevice struct for SessionDevice
+// SessionDevice Device corresponding to a Session
 type SessionDevice struct {
-	// UserAgent of this device
+	// CreatedAt is a helper struct field for gobuffalo.pop.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        string     `json:"id"`
+	// IPAddress of the client
+	IpAddress *string `json:"ip_address,omitempty"`
+	// The Device Last Seen Timestamp  When this device was last seen using the session.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// Name of the device as set by the client using the `X-Device-Name` HTTP header
+	Name *string `json:"name,omitempty"`
+	// UserAgent of the client
 	UserAgent *string `json:"user_agent,omitempty"`
 }
 
@@ -25,8 +35,9 @@ type SessionDevice struct {
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewSessionDevice() *SessionDevice {
+func NewSessionDevice(id string) *SessionDevice {
 	this := SessionDevice{}
+	this.Id = id
 	return &this
 }
 
@@ -38,6 +49,158 @@ func NewSessionDeviceWithDefaults() *SessionDevice {
 	return &this
 }
 
+// GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
+func (o *SessionDevice) GetCreatedAt() time.Time {
+	if o == nil || o.CreatedAt == nil {
+		var ret time.Time
+		return ret
+	}
+	return *o.CreatedAt
+}
+
+// GetCreatedAtOk returns a tuple with the CreatedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SessionDevice) GetCreatedAtOk() (*time.Time, bool) {
+	if o == nil || o.CreatedAt == nil {
+		return nil, false
+	}
+	return o.CreatedAt, true
+}
+
+// HasCreatedAt returns a boolean if a field has been set.
+func (o *SessionDevice) HasCreatedAt() bool {
+	if o != nil && o.CreatedAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedAt gets a reference to the given time.Time and assigns it to the CreatedAt field.
+func (o *SessionDevice) SetCreatedAt(v time.Time) {
+	o.CreatedAt = &v
+}
+
+// GetId returns the Id field value
+func (o *SessionDevice) GetId() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Id
+}
+
+// GetIdOk returns a tuple with the Id field value
+// and a boolean to check if the value has been set.
+func (o *SessionDevice) GetIdOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Id, true
+}
+
+// SetId sets field value
+func (o *SessionDevice) SetId(v string) {
+	o.Id = v
+}
+
+// GetIpAddress returns the IpAddress field value if set, zero value otherwise.
+func (o *SessionDevice) GetIpAddress() string {
+	if o == nil || o.IpAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.IpAddress
+}
+
+// GetIpAddressOk returns a tuple with the IpAddress field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SessionDevice) GetIpAddressOk() (*string, bool) {
+	if o == nil || o.IpAddress == nil {
+		return nil, false
+	}
+	return o.IpAddress, true
+}
+
+// HasIpAddress returns a boolean if a field has been set.
+func (o *SessionDevice) HasIpAddress() bool {
+	if o != nil && o.IpAddress != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetIpAddress gets a reference to the given string and assigns it to the IpAddress field.
+func (o *SessionDevice) SetIpAddress(v string) {
+	o.IpAddress = &v
+}
+
+// GetLastSeenAt returns the LastSeenAt field value if set, zero value otherwise.
+func (o *SessionDevice) GetLastSeenAt() time.Time {
+	if o == nil || o.LastSeenAt == nil {
+		var ret time.Time
+		return ret
+	}
+	return *o.LastSeenAt
+}
+
+// GetLastSeenAtOk returns a tuple with the LastSeenAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SessionDevice) GetLastSeenAtOk() (*time.Time, bool) {
+	if o == nil || o.LastSeenAt == nil {
+		return nil, false
+	}
+	return o.LastSeenAt, true
+}
+
+// HasLastSeenAt returns a boolean if a field has been set.
+func (o *SessionDevice) HasLastSeenAt() bool {
+	if o != nil && o.LastSeenAt != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetLastSeenAt gets a reference to the given time.Time and assigns it to the LastSeenAt field.
+func (o *SessionDevice) SetLastSeenAt(v time.Time) {
+	o.LastSeenAt = &v
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *SessionDevice) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SessionDevice) GetNameOk() (*string, bool) {
+	if o == nil || o.Name == nil {
+		return nil, false
+	}
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *SessionDevice) HasName() bool {
+	if o != nil && o.Name != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetName gets a reference to the given string and assigns it to the Name field.
+func (o *SessionDevice) SetName(v string) {
+	o.Name = &v
+}
+
 // GetUserAgent returns the UserAgent field value if set, zero value otherwise.
 func (o *SessionDevice) GetUserAgent() string {
 	if o == nil || o.UserAgent == nil {
@@ -72,6 +235,21 @@ func (o *SessionDevice) SetUserAgent(v string) {
 
 func (o SessionDevice) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
+	if o.CreatedAt != nil {
+		toSerialize["created_at"] = o.CreatedAt
+	}
+	if true {
+		toSerialize["id"] = o.Id
+	}
+	if o.IpAddress != nil {
+		toSerialize["ip_address"] = o.IpAddress
+	}
+	if o.LastSeenAt != nil {
+		toSerialize["last_seen_at"] = o.LastSeenAt
+	}
+	if o.Name != nil {
+		toSerialize["name"] = o.Name
+	}
 	if o.UserAgent != nil {
 		toSerialize["user_agent"] = o.UserAgent
 	}
@@ -17,12 +17,16 @@ import (
 
 // SubmitSelfServiceVerificationFlowWithLinkMethodBody struct for SubmitSelfServiceVerificationFlowWithLinkMethodBody
 type SubmitSelfServiceVerificationFlowWithLinkMethodBody struct {
+	// Code sent via SMS  Completes the flow if it is the code which was sent to the phone number.
+	Code *string `json:"code,omitempty"`
 	// Sending the anti-csrf token is only required for browser login flows.
 	CsrfToken *string `json:"csrf_token,omitempty"`
-	// Email to Verify  Needs to be set when initiating the flow. If the email is a registered verification email, a verification link will be sent. If the email is not known, a email with details on what happened will be sent instead.  format: email
+	// Email to Verify  Needs to be set when initiating the flow. If the email is a registered verification email, a verification link will be sent. If the email is not known, a email with details on what happened will be sent instead.  Can be omitted in favor of `phone` if `courier.sms.enabled` is true.  format: email
 	Email string `json:"email"`
 	// Method supports `link` only right now.
 	Method string `json:"method"`
+	// Phone Number to Verify  Can be set instead of `email` when initiating the flow if `courier.sms.enabled` is true. If the phone number is a registered verification address, an SMS containing a code will be sent. No SMS is sent to unknown phone numbers.
+	Phone *string `json:"phone,omitempty"`
 }
 
 // NewSubmitSelfServiceVerificationFlowWithLinkMethodBody instantiates a new SubmitSelfServiceVerificationFlowWithLinkMethodBody object
@@ -44,6 +48,38 @@ func NewSubmitSelfServiceVerificationFlowWithLinkMethodBodyWithDefaults() *Submi
 	return &this
 }
 
+// GetCode returns the Code field value if set, zero value otherwise.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) GetCode() string {
+	if o == nil || o.Code == nil {
+		var ret string
+		return ret
+	}
+	return *o.Code
+}
+
+// GetCodeOk returns a tuple with the Code field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) GetCodeOk() (*string, bool) {
+	if o == nil || o.Code == nil {
+		return nil, false
+	}
+	return o.Code, true
+}
+
+// HasCode returns a boolean if a field has been set.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) HasCode() bool {
+	if o != nil && o.Code != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetCode gets a reference to the given string and assigns it to the Code field.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) SetCode(v string) {
+	o.Code = &v
+}
+
 // GetCsrfToken returns the CsrfToken field value if set, zero value otherwise.
 func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) GetCsrfToken() string {
 	if o == nil || o.CsrfToken == nil {
@@ -124,8 +160,43 @@ func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) SetMethod(v string
 	o.Method = v
 }
 
+// GetPhone returns the Phone field value if set, zero value otherwise.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) GetPhone() string {
+	if o == nil || o.Phone == nil {
+		var ret string
+		return ret
+	}
+	return *o.Phone
+}
+
+// GetPhoneOk returns a tuple with the Phone field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) GetPhoneOk() (*string, bool) {
+	if o == nil || o.Phone == nil {
+		return nil, false
+	}
+	return o.Phone, true
+}
+
+// HasPhone returns a boolean if a field has been set.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) HasPhone() bool {
+	if o != nil && o.Phone != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPhone gets a reference to the given string and assigns it to the Phone field.
+func (o *SubmitSelfServiceVerificationFlowWithLinkMethodBody) SetPhone(v string) {
+	o.Phone = &v
+}
+
 func (o SubmitSelfServiceVerificationFlowWithLinkMethodBody) MarshalJSON() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
+	if o.Code != nil {
+		toSerialize["code"] = o.Code
+	}
 	if o.CsrfToken != nil {
 		toSerialize["csrf_token"] = o.CsrfToken
 	}
@@ -135,6 +206,9 @@ func (o SubmitSelfServiceVerificationFlowWithLinkMethodBody) MarshalJSON() ([]by
 	if true {
 		toSerialize["method"] = o.Method
 	}
+	if o.Phone != nil {
+		toSerialize["phone"] = o.Phone
+	}
 	return json.Marshal(toSerialize)
 }
 
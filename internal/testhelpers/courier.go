@@ -33,3 +33,10 @@ func CourierExpectLinkInMessage(t *testing.T, message *courier.Message, offset i
 
 	return match[offset]
 }
+
+func CourierExpectCodeInMessage(t *testing.T, message *courier.Message) string {
+	match := regexp.MustCompile(`code is: (\d+)`).FindStringSubmatch(message.Body)
+	require.Len(t, match, 2, "%s", message.Body)
+
+	return match[1]
+}
@@ -26,6 +26,10 @@ func (p *SessionLifespanProvider) SessionLifespan() time.Duration {
 	return p.e
 }
 
+func (p *SessionLifespanProvider) SessionIdleTimeout() time.Duration {
+	return 0
+}
+
 func NewSessionLifespanProvider(expiresIn time.Duration) *SessionLifespanProvider {
 	return &SessionLifespanProvider{e: expiresIn}
 }
@@ -0,0 +1 @@
+INSERT INTO identities (id, nid, schema_id, traits, created_at, updated_at, state, metadata_public, metadata_admin) VALUES ('28ff0031-190b-4253-bd15-14308dec013e', '884f556e-eb3a-4b9f-bee3-11345642c6c0', 'default', '{"email":"metadata@ory.sh"}', '2013-10-07 08:23:19', '2013-10-07 08:23:19', 'active', '{"foo":"bar"}', '{"baz":"bar"}');
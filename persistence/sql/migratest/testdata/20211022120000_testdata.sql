@@ -0,0 +1 @@
+INSERT INTO identity_history (id, nid, identity_id, cause, changes, created_at, updated_at) VALUES ('3a6e2d1c-5b4f-4a8e-9d7c-2f1b0e9a8c7d', '884f556e-eb3a-4b9f-bee3-11345642c6c0', '28ff0031-190b-4253-bd15-14308dec013e', 'admin_api', '[{"field":"state","old":"inactive","new":"active"}]', '2013-10-07 08:23:19', '2013-10-07 08:23:19');
@@ -0,0 +1 @@
+INSERT INTO identities (id, nid, schema_id, traits, created_at, updated_at, state, external_id) VALUES ('4d64fa08-20fc-450d-bebd-ebd7c7b6e249', '884f556e-eb3a-4b9f-bee3-11345642c6c0', 'default', '{"email":"external-id@ory.sh"}', '2013-10-07 08:23:19', '2013-10-07 08:23:19', 'active', 'customer-4711');
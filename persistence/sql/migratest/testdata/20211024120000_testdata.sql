@@ -0,0 +1 @@
+INSERT INTO identity_unique_traits (id, nid, identity_id, path, value, created_at, updated_at) VALUES ('a5bd5e1f-3cd2-4c1e-9c3e-4b7a0f6d2e11', '884f556e-eb3a-4b9f-bee3-11345642c6c0', '4d64fa08-20fc-450d-bebd-ebd7c7b6e249', 'traits.username', 'external-id', '2013-10-07 08:23:19', '2013-10-07 08:23:19');
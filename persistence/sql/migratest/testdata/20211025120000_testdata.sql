@@ -0,0 +1,5 @@
+INSERT INTO identity_verification_tokens (id, nid, token, code, code_attempts, used, used_at, identity_verifiable_address_id, selfservice_verification_flow_id, created_at, updated_at, expires_at, issued_at)
+VALUES ('9b2c1d6e-5f0a-4c8e-8d3b-2a7e6f1c4b90', '884f556e-eb3a-4b9f-bee3-11345642c6c0', '1001ba7ddd644cb68478e8947e4jfhf', '6b0e4cbd0f1d7c5e3a2b9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c', 1, false, null, '45e867e9-2745-4f16-8dd4-84334a252b61', '5385c962-0295-4575-9b1b-d7eef13c0eda', '2013-10-07 08:23:19', '2013-10-07 08:23:19', '2013-10-07 08:23:19', '2013-10-07 08:23:19');
+
+INSERT INTO identity_recovery_tokens (id, nid, token, code, code_attempts, used, used_at, identity_id, identity_recovery_address_id, selfservice_recovery_flow_id, created_at, updated_at, expires_at, issued_at)
+VALUES ('0d6f4b2a-8c1e-4e7b-9a3d-5c2f1e0b7a64', '884f556e-eb3a-4b9f-bee3-11345642c6c0', '1001ba7ddd644cb68478e8947e4jfhg', '7c1f5dce1f2e8d6f4b3c0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d', 0, false, null, 'a251ebc2-880c-4f76-a8f3-38e6940eab0e', 'b8293f1c-010f-45d9-b809-f3fc5365ba80', '13178936-095a-466b-abe0-36d977d3dc18', '2013-10-07 08:23:19', '2013-10-07 08:23:19', '2013-10-07 08:23:19', '2013-10-07 08:23:19');
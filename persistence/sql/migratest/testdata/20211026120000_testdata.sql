@@ -0,0 +1 @@
+INSERT INTO session_devices (id, nid, session_id, ip_address, user_agent, name, last_seen_at, created_at, updated_at) VALUES ('0f3e5d8a-6c2b-4e1f-9a7d-3b8c1e2f4a5d', '884f556e-eb3a-4b9f-bee3-11345642c6c0', '7458af86-c1d8-401c-978a-8da89133f78b', '127.0.0.1', 'Mozilla/5.0 (X11; Linux x86_64; rv:93.0) Gecko/20100101 Firefox/93.0', 'My Laptop', '2013-10-07 08:23:19', '2013-10-07 08:23:19', '2013-10-07 08:23:19');
@@ -0,0 +1 @@
+ALTER TABLE "selfservice_settings_flows" DROP COLUMN "identity_version";
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "identities" ADD COLUMN "version" INTEGER NOT NULL DEFAULT '0';
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE `identities` DROP COLUMN `version`;
\ No newline at end of file
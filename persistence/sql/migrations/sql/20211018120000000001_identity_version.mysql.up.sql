@@ -0,0 +1 @@
+ALTER TABLE `selfservice_settings_flows` ADD COLUMN `identity_version` INTEGER NOT NULL DEFAULT 0;
\ No newline at end of file
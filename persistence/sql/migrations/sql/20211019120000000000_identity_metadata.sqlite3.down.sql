@@ -0,0 +1 @@
+ALTER TABLE "_identities_tmp" RENAME TO "identities";
\ No newline at end of file
@@ -0,0 +1 @@
+INSERT INTO "_identities_tmp" (id, schema_id, traits, created_at, updated_at, nid, state, state_changed_at, version) SELECT id, schema_id, traits, created_at, updated_at, nid, state, state_changed_at, version FROM "identities";
\ No newline at end of file
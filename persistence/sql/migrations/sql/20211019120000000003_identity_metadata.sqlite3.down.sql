@@ -0,0 +1 @@
+CREATE INDEX "identities_nid_idx" ON "_identities_tmp" (id, nid);
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE "_identities_tmp" (
+"id" TEXT PRIMARY KEY,
+"schema_id" TEXT NOT NULL,
+"traits" TEXT NOT NULL,
+"created_at" DATETIME NOT NULL,
+"updated_at" DATETIME NOT NULL,
+"nid" char(36),
+"state" TEXT NOT NULL DEFAULT 'active',
+"state_changed_at" DATETIME,
+"version" INTEGER NOT NULL DEFAULT '0'
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "identities_nid_idx";
\ No newline at end of file
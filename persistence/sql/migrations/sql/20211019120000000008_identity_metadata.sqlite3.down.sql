@@ -0,0 +1 @@
+INSERT INTO "_identities_tmp" (id, schema_id, traits, created_at, updated_at, nid, state, state_changed_at, version, metadata_public) SELECT id, schema_id, traits, created_at, updated_at, nid, state, state_changed_at, version, metadata_public FROM "identities";
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "identities" DROP COLUMN "state_reason";
\ No newline at end of file
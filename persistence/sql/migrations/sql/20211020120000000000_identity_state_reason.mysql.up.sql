@@ -0,0 +1 @@
+ALTER TABLE `identities` ADD COLUMN `state_reason` text;
\ No newline at end of file
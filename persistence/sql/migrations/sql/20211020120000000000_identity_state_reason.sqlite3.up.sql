@@ -0,0 +1 @@
+ALTER TABLE "identities" ADD COLUMN "state_reason" TEXT;
\ No newline at end of file
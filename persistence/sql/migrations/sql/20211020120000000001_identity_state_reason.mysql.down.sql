@@ -0,0 +1 @@
+ALTER TABLE `identities` DROP COLUMN `reactivate_at`;
\ No newline at end of file
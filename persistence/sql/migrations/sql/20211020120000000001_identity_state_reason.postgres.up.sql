@@ -0,0 +1 @@
+ALTER TABLE "identities" ADD COLUMN "reactivate_at" timestamp;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE "identity_history" (
+"id" UUID NOT NULL,
+PRIMARY KEY("id"),
+"nid" UUID NOT NULL,
+"identity_id" UUID NOT NULL,
+"cause" VARCHAR (64) NOT NULL,
+"changes" json NOT NULL,
+"created_at" timestamp NOT NULL,
+"updated_at" timestamp NOT NULL,
+CONSTRAINT "identity_history_identities_id_fk" FOREIGN KEY ("identity_id") REFERENCES "identities" ("id") ON DELETE cascade,
+CONSTRAINT "identity_history_networks_id_fk" FOREIGN KEY ("nid") REFERENCES "networks" ("id") ON DELETE cascade
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE `identity_history`;
\ No newline at end of file
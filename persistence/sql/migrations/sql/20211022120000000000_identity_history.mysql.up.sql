@@ -0,0 +1,12 @@
+CREATE TABLE `identity_history` (
+`id` char(36) NOT NULL,
+PRIMARY KEY(`id`),
+`nid` char(36) NOT NULL,
+`identity_id` char(36) NOT NULL,
+`cause` VARCHAR (64) NOT NULL,
+`changes` JSON NOT NULL,
+`created_at` DATETIME NOT NULL,
+`updated_at` DATETIME NOT NULL,
+FOREIGN KEY (`identity_id`) REFERENCES `identities` (`id`) ON DELETE cascade,
+FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE cascade
+) ENGINE=InnoDB;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE "identity_history" (
+"id" UUID NOT NULL,
+PRIMARY KEY("id"),
+"nid" UUID NOT NULL,
+"identity_id" UUID NOT NULL,
+"cause" VARCHAR (64) NOT NULL,
+"changes" jsonb NOT NULL,
+"created_at" timestamp NOT NULL,
+"updated_at" timestamp NOT NULL,
+FOREIGN KEY ("identity_id") REFERENCES "identities" ("id") ON DELETE cascade,
+FOREIGN KEY ("nid") REFERENCES "networks" ("id") ON DELETE cascade
+);
\ No newline at end of file
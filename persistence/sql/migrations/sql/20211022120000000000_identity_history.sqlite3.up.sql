@@ -0,0 +1,11 @@
+CREATE TABLE "identity_history" (
+"id" TEXT PRIMARY KEY,
+"nid" char(36) NOT NULL,
+"identity_id" char(36) NOT NULL,
+"cause" TEXT NOT NULL,
+"changes" TEXT NOT NULL,
+"created_at" DATETIME NOT NULL,
+"updated_at" DATETIME NOT NULL,
+FOREIGN KEY (identity_id) REFERENCES identities (id) ON DELETE cascade,
+FOREIGN KEY (nid) REFERENCES networks (id) ON DELETE cascade
+);
\ No newline at end of file
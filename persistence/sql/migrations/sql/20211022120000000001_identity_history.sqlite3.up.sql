@@ -0,0 +1 @@
+CREATE INDEX "identity_history_identity_id_nid_created_at_idx" ON "identity_history" (identity_id, nid, created_at);
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "identities" DROP COLUMN "external_id";
\ No newline at end of file
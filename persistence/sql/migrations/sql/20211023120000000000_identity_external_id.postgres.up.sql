@@ -0,0 +1 @@
+ALTER TABLE "identities" ADD COLUMN "external_id" VARCHAR (255);
\ No newline at end of file
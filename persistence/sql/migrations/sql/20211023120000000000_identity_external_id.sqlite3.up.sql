@@ -0,0 +1 @@
+ALTER TABLE "identities" ADD COLUMN "external_id" TEXT;
\ No newline at end of file
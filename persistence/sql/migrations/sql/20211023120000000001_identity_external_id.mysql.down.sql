@@ -0,0 +1 @@
+DROP INDEX `identities_nid_external_id_uq_idx` ON `identities`;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX `identities_nid_external_id_uq_idx` ON `identities` (`nid`, `external_id`);
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE "identity_unique_traits" (
+"id" UUID NOT NULL,
+PRIMARY KEY("id"),
+"nid" UUID NOT NULL,
+"identity_id" UUID NOT NULL,
+"path" VARCHAR (255) NOT NULL,
+"value" VARCHAR (255) NOT NULL,
+"created_at" timestamp NOT NULL,
+"updated_at" timestamp NOT NULL,
+FOREIGN KEY ("identity_id") REFERENCES "identities" ("id") ON DELETE cascade,
+FOREIGN KEY ("nid") REFERENCES "networks" ("id") ON DELETE cascade
+);
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX "identity_unique_traits_nid_path_value_uq_idx" ON "identity_unique_traits" (nid, path, value);
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX "identity_unique_traits_identity_id_nid_idx" ON "identity_unique_traits" (identity_id, nid);
\ No newline at end of file
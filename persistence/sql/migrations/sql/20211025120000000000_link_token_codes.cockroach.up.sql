@@ -0,0 +1 @@
+ALTER TABLE "identity_verification_tokens" ADD COLUMN "code" VARCHAR (64);
\ No newline at end of file
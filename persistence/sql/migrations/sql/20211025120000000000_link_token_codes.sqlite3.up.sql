@@ -0,0 +1 @@
+ALTER TABLE "identity_verification_tokens" ADD COLUMN "code" TEXT;
\ No newline at end of file
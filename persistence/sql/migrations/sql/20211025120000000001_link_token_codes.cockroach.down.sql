@@ -0,0 +1 @@
+ALTER TABLE "identity_verification_tokens" DROP COLUMN "code_attempts";
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "identity_verification_tokens" ADD COLUMN "code_attempts" int NOT NULL DEFAULT '0';
\ No newline at end of file
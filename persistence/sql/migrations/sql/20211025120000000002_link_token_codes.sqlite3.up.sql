@@ -0,0 +1 @@
+ALTER TABLE "identity_recovery_tokens" ADD COLUMN "code" TEXT;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX "identity_verification_tokens_token_uq_idx" ON "_identity_verification_tokens_tmp" (token);
\ No newline at end of file
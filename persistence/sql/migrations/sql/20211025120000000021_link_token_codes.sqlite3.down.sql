@@ -0,0 +1 @@
+CREATE INDEX "identity_verification_tokens_nid_idx" ON "_identity_verification_tokens_tmp" (id, nid);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "identity_verification_tokens_verification_flow_id_idx";
\ No newline at end of file
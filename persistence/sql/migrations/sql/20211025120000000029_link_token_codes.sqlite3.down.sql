@@ -0,0 +1,2 @@
+
+DROP TABLE "identity_recovery_tokens";
\ No newline at end of file
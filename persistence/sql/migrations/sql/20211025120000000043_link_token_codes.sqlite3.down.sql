@@ -0,0 +1 @@
+CREATE UNIQUE INDEX "identity_recovery_addresses_code_uq_idx" ON "_identity_recovery_tokens_tmp" (token);
\ No newline at end of file
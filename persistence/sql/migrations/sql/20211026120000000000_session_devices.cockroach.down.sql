@@ -0,0 +1 @@
+DROP TABLE "session_devices";
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE `session_devices` (
+`id` char(36) NOT NULL,
+PRIMARY KEY(`id`),
+`nid` char(36) NOT NULL,
+`session_id` char(36) NOT NULL,
+`ip_address` VARCHAR (64) NOT NULL,
+`user_agent` VARCHAR (512) NOT NULL,
+`name` VARCHAR (512) NOT NULL,
+`last_seen_at` DATETIME NOT NULL,
+`created_at` DATETIME NOT NULL,
+`updated_at` DATETIME NOT NULL,
+FOREIGN KEY (`session_id`) REFERENCES `sessions` (`id`) ON DELETE cascade,
+FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE cascade
+) ENGINE=InnoDB;
\ No newline at end of file
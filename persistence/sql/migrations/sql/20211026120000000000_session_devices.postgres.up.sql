@@ -0,0 +1,14 @@
+CREATE TABLE "session_devices" (
+"id" UUID NOT NULL,
+PRIMARY KEY("id"),
+"nid" UUID NOT NULL,
+"session_id" UUID NOT NULL,
+"ip_address" VARCHAR (64) NOT NULL,
+"user_agent" VARCHAR (512) NOT NULL,
+"name" VARCHAR (512) NOT NULL,
+"last_seen_at" timestamp NOT NULL,
+"created_at" timestamp NOT NULL,
+"updated_at" timestamp NOT NULL,
+FOREIGN KEY ("session_id") REFERENCES "sessions" ("id") ON DELETE cascade,
+FOREIGN KEY ("nid") REFERENCES "networks" ("id") ON DELETE cascade
+);
\ No newline at end of file
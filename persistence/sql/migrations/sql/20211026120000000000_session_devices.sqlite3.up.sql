@@ -0,0 +1,13 @@
+CREATE TABLE "session_devices" (
+"id" TEXT PRIMARY KEY,
+"nid" char(36) NOT NULL,
+"session_id" char(36) NOT NULL,
+"ip_address" TEXT NOT NULL,
+"user_agent" TEXT NOT NULL,
+"name" TEXT NOT NULL,
+"last_seen_at" DATETIME NOT NULL,
+"created_at" DATETIME NOT NULL,
+"updated_at" DATETIME NOT NULL,
+FOREIGN KEY (session_id) REFERENCES sessions (id) ON DELETE cascade,
+FOREIGN KEY (nid) REFERENCES networks (id) ON DELETE cascade
+);
\ No newline at end of file
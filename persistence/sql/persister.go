@@ -206,3 +206,15 @@ func (p *Persister) delete(ctx context.Context, v interface{}, id uuid.UUID) err
 	}
 	return nil
 }
+
+// paginate orders the query by descending ID and limits it to the given page.
+func paginate(q *pop.Query, page x.Page) *pop.Query {
+	if page.UseOffset {
+		return q.Paginate(page.Offset, page.ItemsPerPage).Order("id DESC")
+	}
+
+	if page.Token != uuid.Nil {
+		q = q.Where("id < ?", page.Token)
+	}
+	return q.Order("id DESC").Limit(page.ItemsPerPage)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -30,16 +32,23 @@ import (
 var _ identity.Pool = new(Persister)
 var _ identity.PrivilegedPool = new(Persister)
 
-func (p *Persister) ListVerifiableAddresses(ctx context.Context, page, itemsPerPage int) (a []identity.VerifiableAddress, err error) {
-	if err := p.GetConnection(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).Order("id DESC").Paginate(page, x.MaxItemsPerPage(itemsPerPage)).All(&a); err != nil {
+func (p *Persister) ListVerifiableAddresses(ctx context.Context, filter identity.ListVerifiableAddressesFilter, page x.Page) (a []identity.VerifiableAddress, err error) {
+	page.ItemsPerPage = x.MaxItemsPerPage(page.ItemsPerPage)
+	q := p.GetConnection(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).Where(identityNotDeleted(ctx))
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+
+	if err := paginate(q, page).All(&a); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 
 	return a, err
 }
 
-func (p *Persister) ListRecoveryAddresses(ctx context.Context, page, itemsPerPage int) (a []identity.RecoveryAddress, err error) {
-	if err := p.GetConnection(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).Order("id DESC").Paginate(page, x.MaxItemsPerPage(itemsPerPage)).All(&a); err != nil {
+func (p *Persister) ListRecoveryAddresses(ctx context.Context, page x.Page) (a []identity.RecoveryAddress, err error) {
+	page.ItemsPerPage = x.MaxItemsPerPage(page.ItemsPerPage)
+	if err := paginate(p.GetConnection(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).Where(identityNotDeleted(ctx)), page).All(&a); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 
@@ -69,13 +78,16 @@ func (p *Persister) FindByCredentialsIdentifier(ctx context.Context, ct identity
 FROM %s ic
          INNER JOIN %s ict on ic.identity_credential_type_id = ict.id
          INNER JOIN %s ici on ic.id = ici.identity_credential_id
+         INNER JOIN %s i on ic.identity_id = i.id
 WHERE ici.identifier = ?
   AND ic.nid = ?
   AND ici.nid = ?
-  AND ict.name = ?`,
+  AND ict.name = ?
+  AND i.deleted_at IS NULL`,
 		corp.ContextualizeTableName(ctx, "identity_credentials"),
 		corp.ContextualizeTableName(ctx, "identity_credential_types"),
 		corp.ContextualizeTableName(ctx, "identity_credential_identifiers"),
+		corp.ContextualizeTableName(ctx, "identities"),
 	),
 		match,
 		nid,
@@ -159,6 +171,25 @@ func (p *Persister) createIdentityCredentials(ctx context.Context, i *identity.I
 	return nil
 }
 
+func (p *Persister) createUniqueTraits(ctx context.Context, i *identity.Identity, traits []identity.UniqueTrait) error {
+	for k := range traits {
+		traits[k].IdentityID = i.ID
+		traits[k].NID = corp.ContextualizeNID(ctx, p.nid)
+		if err := p.GetConnection(ctx).Create(&traits[k]); err != nil {
+			return sqlcon.HandleError(err)
+		}
+	}
+	return nil
+}
+
+func (p *Persister) FindIdentityIDByUniqueTrait(ctx context.Context, path, value string) (uuid.UUID, error) {
+	var t identity.UniqueTrait
+	if err := p.GetConnection(ctx).Where("path = ? AND value = ? AND nid = ?", path, value, corp.ContextualizeNID(ctx, p.nid)).First(&t); err != nil {
+		return uuid.Nil, sqlcon.HandleError(err)
+	}
+	return t.IdentityID, nil
+}
+
 func (p *Persister) createVerifiableAddresses(ctx context.Context, i *identity.Identity) error {
 	for k := range i.VerifiableAddresses {
 		i.VerifiableAddresses[k].IdentityID = i.ID
@@ -199,8 +230,104 @@ func (p *Persister) findRecoveryAddresses(ctx context.Context, i *identity.Ident
 	return nil
 }
 
-func (p *Persister) CountIdentities(ctx context.Context) (int64, error) {
-	count, err := p.c.WithContext(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).Count(new(identity.Identity))
+// identityNotDeleted restricts queries on tables referencing identities to identities which were not soft-deleted.
+func identityNotDeleted(ctx context.Context) string {
+	/* #nosec G201 TableName is static */
+	return fmt.Sprintf("identity_id IN (SELECT id FROM %s WHERE deleted_at IS NULL)", corp.ContextualizeTableName(ctx, "identities"))
+}
+
+var traitsFilterPathPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// escapeLike escapes the LIKE wildcards in value using `!` as the escape character, which is understood
+// by all supported dialects without further quoting.
+func escapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
+
+func (p *Persister) traitsFilterColumn(c *pop.Connection, path string) (string, error) {
+	if !traitsFilterPathPattern.MatchString(path) {
+		return "", errors.WithStack(herodot.ErrBadRequest.WithReasonf(`The traits filter path "%s" is invalid. Each segment separated by "." must start with a letter or "_" followed by letters, digits, or "_".`, path))
+	}
+
+	// The path is safe to interpolate because it was validated above. All dialects return the value as text
+	// so that numbers and booleans compare the same way everywhere.
+	switch c.Dialect.Name() {
+	case "postgres", "cockroach":
+		return fmt.Sprintf("traits #>> '{%s}'", strings.ReplaceAll(path, ".", ",")), nil
+	case "mysql":
+		return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(traits, '$.%s'))", path), nil
+	default:
+		// SQLite returns JSON booleans as 1 and 0 which is why they are mapped back to their JSON representation.
+		return fmt.Sprintf("(CASE json_type(traits, '$.%[1]s') WHEN 'true' THEN 'true' WHEN 'false' THEN 'false' ELSE CAST(json_extract(traits, '$.%[1]s') AS TEXT) END)", path), nil
+	}
+}
+
+func (p *Persister) filterIdentities(ctx context.Context, filter identity.ListIdentitiesFilter) (*pop.Query, error) {
+	c := p.GetConnection(ctx)
+	nid := corp.ContextualizeNID(ctx, p.nid)
+	q := c.Where("nid = ? AND deleted_at IS NULL", nid)
+
+	for _, m := range []struct{ operator, match string }{
+		{operator: "=", match: filter.CredentialsIdentifier},
+		{operator: "LIKE", match: filter.CredentialsIdentifierPrefix},
+	} {
+		if m.match == "" {
+			continue
+		}
+
+		match, suffix := m.match, ""
+		if m.operator == "LIKE" {
+			match, suffix = escapeLike(match)+"%", " ESCAPE '!'"
+		}
+
+		// Password identifiers are stored in lower case which is why we also match the lower-cased value.
+		// #nosec G201
+		q = q.Where(fmt.Sprintf(`id IN (SELECT ic.identity_id FROM %s ic INNER JOIN %s ici ON ic.id = ici.identity_credential_id WHERE ic.nid = ? AND ici.nid = ? AND (ici.identifier %s ?%s OR ici.identifier %s ?%s))`,
+			corp.ContextualizeTableName(ctx, "identity_credentials"),
+			corp.ContextualizeTableName(ctx, "identity_credential_identifiers"),
+			m.operator, suffix, m.operator, suffix,
+		), nid, nid, match, strings.ToLower(match))
+	}
+
+	paths := make([]string, 0, len(filter.Traits))
+	for path := range filter.Traits {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		column, err := p.traitsFilterColumn(c, path)
+		if err != nil {
+			return nil, err
+		}
+		q = q.Where(column+" = ?", filter.Traits[path])
+	}
+
+	if filter.State != "" {
+		q = q.Where("state = ?", filter.State)
+	}
+
+	if filter.SchemaID != "" {
+		q = q.Where("schema_id = ?", filter.SchemaID)
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		q = q.Where("created_at >= ?", filter.CreatedAfter.UTC())
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		q = q.Where("created_at <= ?", filter.CreatedBefore.UTC())
+	}
+
+	return q, nil
+}
+
+func (p *Persister) CountIdentities(ctx context.Context, filter identity.ListIdentitiesFilter) (int64, error) {
+	q, err := p.filterIdentities(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := q.Count(new(identity.Identity))
 	if err != nil {
 		return 0, sqlcon.HandleError(err)
 	}
@@ -232,11 +359,20 @@ func (p *Persister) CreateIdentity(ctx context.Context, i *identity.Identity) er
 		return err
 	}
 
+	uniqueTraits, err := p.r.IdentityValidator().UniqueTraits(ctx, i)
+	if err != nil {
+		return err
+	}
+
 	return p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) error {
 		if err := tx.Create(i); err != nil {
 			return sqlcon.HandleError(err)
 		}
 
+		if err := p.createUniqueTraits(ctx, i, uniqueTraits); err != nil {
+			return err
+		}
+
 		if err := p.createVerifiableAddresses(ctx, i); err != nil {
 			return sqlcon.HandleError(err)
 		}
@@ -249,13 +385,17 @@ func (p *Persister) CreateIdentity(ctx context.Context, i *identity.Identity) er
 	})
 }
 
-func (p *Persister) ListIdentities(ctx context.Context, page, perPage int) ([]identity.Identity, error) {
+func (p *Persister) ListIdentities(ctx context.Context, filter identity.ListIdentitiesFilter, page x.Page) ([]identity.Identity, error) {
 	is := make([]identity.Identity, 0)
 
+	q, err := p.filterIdentities(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	/* #nosec G201 TableName is static */
-	if err := sqlcon.HandleError(p.GetConnection(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).
-		EagerPreload("VerifiableAddresses", "RecoveryAddresses").
-		Paginate(page, perPage).Order("id DESC").
+	if err := sqlcon.HandleError(paginate(q.
+		EagerPreload("VerifiableAddresses", "RecoveryAddresses"), page).
 		All(&is)); err != nil {
 		return nil, err
 	}
@@ -288,18 +428,42 @@ func (p *Persister) UpdateIdentity(ctx context.Context, i *identity.Identity) er
 		return err
 	}
 
+	uniqueTraits, err := p.r.IdentityValidator().UniqueTraits(ctx, i)
+	if err != nil {
+		return err
+	}
+
 	i.NID = corp.ContextualizeNID(ctx, p.nid)
-	return sqlcon.HandleError(p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) error {
-		if count, err := tx.Where("id = ? AND nid = ?", i.ID, corp.ContextualizeNID(ctx, p.nid)).Count(i); err != nil {
+	version := i.Version
+	if err := p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) error {
+		if count, err := tx.Where("id = ? AND nid = ? AND deleted_at IS NULL", i.ID, corp.ContextualizeNID(ctx, p.nid)).Count(i); err != nil {
 			return err
 		} else if count == 0 {
 			return sql.ErrNoRows
 		}
 
+		// Only bump the version if nobody else updated the identity since it was loaded.
+		/* #nosec G201 TableName is static */
+		if count, err := tx.RawQuery(fmt.Sprintf(
+			`UPDATE %s SET version = version + 1 WHERE id = ? AND nid = ? AND version = ?`, i.TableName(ctx)),
+			i.ID, corp.ContextualizeNID(ctx, p.nid), version).ExecWithCount(); err != nil {
+			return err
+		} else if count == 0 {
+			return errors.WithStack(identity.ErrConcurrentUpdate)
+		}
+		i.Version = version + 1
+
+		if p.r.Config(ctx).IdentityHistoryEnabled() {
+			if err := p.createHistoryEntry(ctx, i); err != nil {
+				return err
+			}
+		}
+
 		for _, tn := range []string{
 			new(identity.Credentials).TableName(ctx),
 			new(identity.VerifiableAddress).TableName(ctx),
 			new(identity.RecoveryAddress).TableName(ctx),
+			new(identity.UniqueTrait).TableName(ctx),
 		} {
 			/* #nosec G201 TableName is static */
 			if err := tx.RawQuery(fmt.Sprintf(
@@ -320,17 +484,140 @@ func (p *Persister) UpdateIdentity(ctx context.Context, i *identity.Identity) er
 			return err
 		}
 
+		if err := p.createUniqueTraits(ctx, i, uniqueTraits); err != nil {
+			return err
+		}
+
 		return p.createIdentityCredentials(ctx, i)
-	}))
+	}); err != nil {
+		i.Version = version
+		return sqlcon.HandleError(err)
+	}
+
+	return nil
 }
 
 func (p *Persister) DeleteIdentity(ctx context.Context, id uuid.UUID) error {
-	return p.delete(ctx, new(identity.Identity), id)
+	if !p.r.Config(ctx).IdentitySoftDeleteEnabled() {
+		return p.delete(ctx, new(identity.Identity), id)
+	}
+
+	return p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) error {
+		nid := corp.ContextualizeNID(ctx, p.nid)
+
+		/* #nosec G201 TableName is static */
+		count, err := tx.RawQuery(fmt.Sprintf(
+			"UPDATE %s SET deleted_at = ? WHERE id = ? AND nid = ? AND deleted_at IS NULL", new(identity.Identity).TableName(ctx)),
+			time.Now().UTC(), id, nid).ExecWithCount()
+		if err != nil {
+			return sqlcon.HandleError(err)
+		}
+		if count == 0 {
+			return errors.WithStack(sqlcon.ErrNoRows)
+		}
+
+		// Sessions are removed by the foreign key when an identity is deleted, which is why they
+		// have to be removed explicitly when the identity is only marked as deleted.
+		/* #nosec G201 TableName is static */
+		if err := tx.RawQuery(fmt.Sprintf(
+			"DELETE FROM %s WHERE identity_id = ? AND nid = ?", corp.ContextualizeTableName(ctx, "sessions")),
+			id, nid).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		return nil
+	})
+}
+
+func (p *Persister) RestoreIdentity(ctx context.Context, id uuid.UUID) error {
+	/* #nosec G201 TableName is static */
+	count, err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"UPDATE %s SET deleted_at = NULL WHERE id = ? AND nid = ? AND deleted_at IS NOT NULL AND deleted_at > ?", new(identity.Identity).TableName(ctx)),
+		id,
+		corp.ContextualizeNID(ctx, p.nid),
+		time.Now().UTC().Add(-p.r.Config(ctx).IdentitySoftDeleteRetention()),
+	).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	}
+	if count == 0 {
+		return errors.WithStack(sqlcon.ErrNoRows)
+	}
+	return nil
+}
+
+func (p *Persister) PurgeDeletedIdentities(ctx context.Context, deletedBefore time.Time) (int, error) {
+	/* #nosec G201 TableName is static */
+	count, err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"DELETE FROM %s WHERE nid = ? AND deleted_at IS NOT NULL AND deleted_at < ?", new(identity.Identity).TableName(ctx)),
+		corp.ContextualizeNID(ctx, p.nid),
+		deletedBefore.UTC(),
+	).ExecWithCount()
+	if err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	return count, nil
+}
+
+// createHistoryEntry records the changes between the stored identity and i. It must be called within the
+// transaction updating the identity and before the identity is updated.
+func (p *Persister) createHistoryEntry(ctx context.Context, i *identity.Identity) error {
+	original, err := p.GetIdentityConfidential(ctx, i.ID)
+	if err != nil {
+		return err
+	}
+
+	changes, err := identity.NewHistoryChanges(original, i)
+	if err != nil {
+		return err
+	} else if len(changes) == 0 {
+		return nil
+	}
+
+	return p.GetConnection(ctx).Create(&identity.HistoryEntry{
+		IdentityID: i.ID,
+		Cause:      identity.HistoryCauseFromContext(ctx),
+		Changes:    changes,
+		NID:        corp.ContextualizeNID(ctx, p.nid),
+	})
+}
+
+func (p *Persister) ListIdentityHistory(ctx context.Context, id uuid.UUID, page, itemsPerPage int) ([]identity.HistoryEntry, int64, error) {
+	q := p.GetConnection(ctx).Where("identity_id = ? AND nid = ? AND created_at > ?",
+		id,
+		corp.ContextualizeNID(ctx, p.nid),
+		time.Now().UTC().Add(-p.r.Config(ctx).IdentityHistoryRetention()),
+	)
+
+	count, err := q.Count(new(identity.HistoryEntry))
+	if err != nil {
+		return nil, 0, sqlcon.HandleError(err)
+	}
+
+	entries := make([]identity.HistoryEntry, 0)
+	if err := q.Paginate(page, itemsPerPage).Order("created_at DESC, id DESC").All(&entries); err != nil {
+		return nil, 0, sqlcon.HandleError(err)
+	}
+
+	return entries, int64(count), nil
+}
+
+func (p *Persister) PurgeIdentityHistory(ctx context.Context, before time.Time) (int, error) {
+	/* #nosec G201 TableName is static */
+	count, err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"DELETE FROM %s WHERE nid = ? AND created_at < ?", new(identity.HistoryEntry).TableName(ctx)),
+		corp.ContextualizeNID(ctx, p.nid),
+		before.UTC(),
+	).ExecWithCount()
+	if err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	return count, nil
 }
 
 func (p *Persister) GetIdentity(ctx context.Context, id uuid.UUID) (*identity.Identity, error) {
 	var i identity.Identity
-	if err := p.GetConnection(ctx).Where("id = ? AND nid = ?", id, corp.ContextualizeNID(ctx, p.nid)).First(&i); err != nil {
+	if err := p.GetConnection(ctx).Where("id = ? AND nid = ? AND deleted_at IS NULL", id, corp.ContextualizeNID(ctx, p.nid)).First(&i); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 
@@ -351,11 +638,20 @@ func (p *Persister) GetIdentity(ctx context.Context, id uuid.UUID) (*identity.Id
 	return &i, nil
 }
 
+func (p *Persister) FindIdentityByExternalID(ctx context.Context, externalID string) (*identity.Identity, error) {
+	var i identity.Identity
+	if err := p.GetConnection(ctx).Where("external_id = ? AND nid = ? AND deleted_at IS NULL", externalID, corp.ContextualizeNID(ctx, p.nid)).First(&i); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	return p.GetIdentity(ctx, i.ID)
+}
+
 func (p *Persister) GetIdentityConfidential(ctx context.Context, id uuid.UUID) (*identity.Identity, error) {
 	var i identity.Identity
 
 	nid := corp.ContextualizeNID(ctx, p.nid)
-	if err := p.GetConnection(ctx).Where("id = ? AND nid = ?", id, nid).First(&i); err != nil {
+	if err := p.GetConnection(ctx).Where("id = ? AND nid = ? AND deleted_at IS NULL", id, nid).First(&i); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 
@@ -403,7 +699,7 @@ func (p *Persister) GetIdentityConfidential(ctx context.Context, id uuid.UUID) (
 
 func (p *Persister) FindVerifiableAddressByValue(ctx context.Context, via identity.VerifiableAddressType, value string) (*identity.VerifiableAddress, error) {
 	var address identity.VerifiableAddress
-	if err := p.GetConnection(ctx).Where("nid = ? AND via = ? AND LOWER(value) = ?", corp.ContextualizeNID(ctx, p.nid), via, strings.ToLower(value)).First(&address); err != nil {
+	if err := p.GetConnection(ctx).Where("nid = ? AND via = ? AND LOWER(value) = ?", corp.ContextualizeNID(ctx, p.nid), via, strings.ToLower(value)).Where(identityNotDeleted(ctx)).First(&address); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 
@@ -412,7 +708,7 @@ func (p *Persister) FindVerifiableAddressByValue(ctx context.Context, via identi
 
 func (p *Persister) FindRecoveryAddressByValue(ctx context.Context, via identity.RecoveryAddressType, value string) (*identity.RecoveryAddress, error) {
 	var address identity.RecoveryAddress
-	if err := p.GetConnection(ctx).Where("nid = ? AND via = ? AND LOWER(value) = ?", corp.ContextualizeNID(ctx, p.nid), via, strings.ToLower(value)).First(&address); err != nil {
+	if err := p.GetConnection(ctx).Where("nid = ? AND via = ? AND LOWER(value) = ?", corp.ContextualizeNID(ctx, p.nid), via, strings.ToLower(value)).Where(identityNotDeleted(ctx)).First(&address); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 
@@ -449,6 +745,15 @@ func (p *Persister) VerifyAddress(ctx context.Context, code string) error {
 	return nil
 }
 
+func (p *Persister) GetVerifiableAddress(ctx context.Context, id uuid.UUID) (*identity.VerifiableAddress, error) {
+	var address identity.VerifiableAddress
+	if err := p.GetConnection(ctx).Where("id = ? AND nid = ?", id, corp.ContextualizeNID(ctx, p.nid)).Where(identityNotDeleted(ctx)).First(&address); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	return &address, nil
+}
+
 func (p *Persister) UpdateVerifiableAddress(ctx context.Context, address *identity.VerifiableAddress) error {
 	address.NID = corp.ContextualizeNID(ctx, p.nid)
 	return p.update(ctx, address)
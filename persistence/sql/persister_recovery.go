@@ -14,6 +14,7 @@ import (
 	"github.com/ory/kratos/selfservice/flow/recovery"
 	"github.com/ory/kratos/selfservice/strategy/link"
 	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
 )
 
 var _ recovery.FlowPersister = new(Persister)
@@ -40,8 +41,11 @@ func (p Persister) UpdateRecoveryFlow(ctx context.Context, r *recovery.Flow) err
 }
 
 func (p *Persister) CreateRecoveryToken(ctx context.Context, token *link.RecoveryToken) error {
-	t := token.Token
+	t, c := token.Token, token.Code
 	token.Token = p.hmacValue(ctx, t)
+	if len(c) > 0 {
+		token.Code = sqlxx.NullString(p.hmacValue(ctx, string(c)))
+	}
 	token.NID = corp.ContextualizeNID(ctx, p.nid)
 
 	// This should not create the request eagerly because otherwise we might accidentally create an address that isn't
@@ -50,7 +54,7 @@ func (p *Persister) CreateRecoveryToken(ctx context.Context, token *link.Recover
 		return err
 	}
 
-	token.Token = t
+	token.Token, token.Code = t, c
 	return nil
 }
 
@@ -89,6 +93,50 @@ func (p *Persister) UseRecoveryToken(ctx context.Context, token string) (*link.R
 	return &rt, nil
 }
 
+func (p *Persister) UseRecoveryCode(ctx context.Context, flowID uuid.UUID, code string) (*link.RecoveryToken, error) {
+	var rt link.RecoveryToken
+
+	nid := corp.ContextualizeNID(ctx, p.nid)
+	var found bool
+	if err := sqlcon.HandleError(p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) (err error) {
+		for _, secret := range p.r.Config(ctx).SecretsSession() {
+			if err = tx.Where("selfservice_recovery_flow_id = ? AND code = ? AND nid = ? AND NOT used AND code_attempts < ?",
+				flowID, p.hmacValueWithSecret(code, secret), nid, link.CodeMaxAttempts).First(&rt); err != nil {
+				if !errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+					return err
+				}
+			} else {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			// Wrong codes count against all codes of the flow as they could otherwise be guessed.
+			/* #nosec G201 TableName is static */
+			return tx.RawQuery(fmt.Sprintf("UPDATE %s SET code_attempts = code_attempts + 1 WHERE selfservice_recovery_flow_id = ? AND nid = ? AND code IS NOT NULL AND NOT used", rt.TableName(ctx)), flowID, nid).Exec()
+		}
+
+		var ra identity.RecoveryAddress
+		if err := tx.Where("id = ? AND nid = ?", rt.RecoveryAddressID, nid).First(&ra); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		rt.RecoveryAddress = &ra
+
+		/* #nosec G201 TableName is static */
+		return tx.RawQuery(fmt.Sprintf("UPDATE %s SET used=true, used_at=? WHERE id=? AND nid = ?", rt.TableName(ctx)), time.Now().UTC(), rt.ID, nid).Exec()
+	})); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, sqlcon.ErrNoRows
+	}
+
+	return &rt, nil
+}
+
 func (p *Persister) DeleteRecoveryToken(ctx context.Context, token string) error {
 	/* #nosec G201 TableName is static */
 	return p.GetConnection(ctx).RawQuery(fmt.Sprintf("DELETE FROM %s WHERE token=? AND nid = ?", new(link.RecoveryToken).TableName(ctx)), token, corp.ContextualizeNID(ctx, p.nid)).Exec()
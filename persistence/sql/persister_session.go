@@ -4,16 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/ory/kratos/corp"
 
+	"github.com/gobuffalo/pop/v5"
 	"github.com/gofrs/uuid"
 
 	"github.com/ory/x/sqlcon"
 
+	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/session"
+	"github.com/ory/kratos/x"
 )
 
 var _ session.Persister = new(Persister)
@@ -32,6 +36,10 @@ func (p *Persister) GetSession(ctx context.Context, sid uuid.UUID) (*session.Ses
 		return nil, err
 	}
 
+	if err := p.findSessionDevices(ctx, &s); err != nil {
+		return nil, err
+	}
+
 	s.Identity = i
 	return &s, nil
 }
@@ -40,14 +48,24 @@ func (p *Persister) UpsertSession(ctx context.Context, s *session.Session) error
 	s.NID = corp.ContextualizeNID(ctx, p.nid)
 
 	if err := p.Connection(ctx).Find(new(session.Session), s.ID); errors.Is(err, sql.ErrNoRows) {
-		// This must not be eager or identities will be created / updated
-		return errors.WithStack(p.GetConnection(ctx).Create(s))
+		return p.Transaction(ctx, func(ctx context.Context, connection *pop.Connection) error {
+			// This must not be eager or identities will be created / updated
+			if err := p.GetConnection(ctx).Create(s); err != nil {
+				return errors.WithStack(err)
+			}
+			return p.createSessionDevices(ctx, s)
+		})
 	} else if err != nil {
 		return errors.WithStack(err)
 	}
 
-	// This must not be eager or identities will be created / updated
-	return p.GetConnection(ctx).Update(s)
+	return p.Transaction(ctx, func(ctx context.Context, connection *pop.Connection) error {
+		// This must not be eager or identities will be created / updated
+		if err := p.GetConnection(ctx).Update(s); err != nil {
+			return err
+		}
+		return p.createSessionDevices(ctx, s)
+	})
 }
 
 func (p *Persister) DeleteSession(ctx context.Context, sid uuid.UUID) error {
@@ -72,6 +90,31 @@ func (p *Persister) DeleteSessionsByIdentity(ctx context.Context, identityID uui
 	return nil
 }
 
+func (p *Persister) DeleteSessionsByAuthenticationMethod(ctx context.Context, identityID uuid.UUID, method identity.CredentialsType) (int, error) {
+	var count int
+	if err := p.Transaction(ctx, func(ctx context.Context, connection *pop.Connection) error {
+		var sessions []session.Session
+		if err := p.GetConnection(ctx).Where("identity_id = ? AND nid = ?", identityID, corp.ContextualizeNID(ctx, p.nid)).All(&sessions); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		// The authentication methods are stored as JSON which can not be queried the same way in all databases.
+		for k := range sessions {
+			if !sessions[k].AuthenticatedWith(method) {
+				continue
+			}
+			if err := p.delete(ctx, new(session.Session), sessions[k].ID); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (p *Persister) GetSessionByToken(ctx context.Context, token string) (*session.Session, error) {
 	var s session.Session
 	if err := p.GetConnection(ctx).Where("token = ? AND nid = ?",
@@ -87,6 +130,11 @@ func (p *Persister) GetSessionByToken(ctx context.Context, token string) (*sessi
 	if err != nil {
 		return nil, err
 	}
+
+	if err := p.findSessionDevices(ctx, &s); err != nil {
+		return nil, err
+	}
+
 	s.Identity = i
 	return &s, nil
 }
@@ -126,3 +174,143 @@ func (p *Persister) RevokeSessionByToken(ctx context.Context, token string) erro
 	}
 	return nil
 }
+
+func (p *Persister) ListSessionsByIdentity(ctx context.Context, identityID uuid.UUID, active *bool, page x.Page) ([]*session.Session, error) {
+	i, err := p.GetIdentity(ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+
+	q := p.GetConnection(ctx).Where("identity_id = ? AND nid = ?", identityID, corp.ContextualizeNID(ctx, p.nid))
+	if active != nil {
+		if *active {
+			q = q.Where("active = ? AND expires_at > ?", true, time.Now().UTC())
+		} else {
+			q = q.Where("(active = ? OR expires_at <= ?)", false, time.Now().UTC())
+		}
+	}
+
+	var s []*session.Session
+	if err := paginate(q, page).All(&s); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	// This is needed because of how identities are fetched from the store (if we use eager not all fields are
+	// available!).
+	for k := range s {
+		s[k].Identity = i
+	}
+
+	if err := p.findDevicesForSessions(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (p *Persister) RevokeSession(ctx context.Context, identityID, sessionID uuid.UUID) error {
+	// #nosec G201
+	count, err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"UPDATE %s SET active = false WHERE id = ? AND identity_id = ? AND nid = ?",
+		corp.ContextualizeTableName(ctx, "sessions"),
+	),
+		sessionID,
+		identityID,
+		corp.ContextualizeNID(ctx, p.nid),
+	).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	}
+	if count == 0 {
+		return errors.WithStack(sqlcon.ErrNoRows)
+	}
+	return nil
+}
+
+func (p *Persister) RevokeSessionsIdentityExcept(ctx context.Context, identityID, except uuid.UUID) (int, error) {
+	// #nosec G201
+	count, err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"UPDATE %s SET active = false WHERE identity_id = ? AND id != ? AND active = true AND nid = ?",
+		corp.ContextualizeTableName(ctx, "sessions"),
+	),
+		identityID,
+		except,
+		corp.ContextualizeNID(ctx, p.nid),
+	).ExecWithCount()
+	if err != nil {
+		return 0, sqlcon.HandleError(err)
+	}
+	return count, nil
+}
+
+func (p *Persister) UpsertSessionDevice(ctx context.Context, d *session.Device) error {
+	d.NID = corp.ContextualizeNID(ctx, p.nid)
+
+	if err := p.GetConnection(ctx).Where("id = ? AND nid = ?", d.ID, d.NID).First(new(session.Device)); errors.Is(err, sql.ErrNoRows) {
+		return sqlcon.HandleError(p.GetConnection(ctx).Create(d))
+	} else if err != nil {
+		return sqlcon.HandleError(err)
+	}
+
+	d.UpdatedAt = time.Now().UTC()
+	// #nosec G201
+	return sqlcon.HandleError(p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"UPDATE %s SET last_seen_at = ?, updated_at = ? WHERE id = ? AND nid = ?",
+		corp.ContextualizeTableName(ctx, "session_devices"),
+	),
+		d.LastSeenAt,
+		d.UpdatedAt,
+		d.ID,
+		d.NID,
+	).Exec())
+}
+
+// createSessionDevices creates the devices of the session which have not been stored yet.
+func (p *Persister) createSessionDevices(ctx context.Context, s *session.Session) error {
+	for k := range s.Devices {
+		if !s.Devices[k].CreatedAt.IsZero() {
+			continue
+		}
+
+		s.Devices[k].SessionID = s.ID
+		s.Devices[k].NID = corp.ContextualizeNID(ctx, p.nid)
+		if err := p.GetConnection(ctx).Create(&s.Devices[k]); err != nil {
+			return sqlcon.HandleError(err)
+		}
+	}
+	return nil
+}
+
+func (p *Persister) findSessionDevices(ctx context.Context, s *session.Session) error {
+	devices := make([]session.Device, 0)
+	if err := p.GetConnection(ctx).Where("session_id = ? AND nid = ?", s.ID, corp.ContextualizeNID(ctx, p.nid)).Order("created_at ASC").All(&devices); err != nil {
+		return sqlcon.HandleError(err)
+	}
+	s.Devices = devices
+	return nil
+}
+
+func (p *Persister) findDevicesForSessions(ctx context.Context, ss []*session.Session) error {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	ids := make([]interface{}, len(ss))
+	for k := range ss {
+		ids[k] = ss[k].ID
+	}
+
+	var devices []session.Device
+	if err := p.GetConnection(ctx).Where("nid = ?", corp.ContextualizeNID(ctx, p.nid)).Where("session_id IN (?)", ids...).Order("created_at ASC").All(&devices); err != nil {
+		return sqlcon.HandleError(err)
+	}
+
+	for _, s := range ss {
+		s.Devices = make([]session.Device, 0)
+		for _, d := range devices {
+			if d.SessionID == s.ID {
+				s.Devices = append(s.Devices, d)
+			}
+		}
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
 
 	"github.com/ory/kratos/selfservice/flow/verification"
 	"github.com/ory/kratos/selfservice/strategy/link"
@@ -43,8 +44,11 @@ func (p Persister) UpdateVerificationFlow(ctx context.Context, r *verification.F
 }
 
 func (p *Persister) CreateVerificationToken(ctx context.Context, token *link.VerificationToken) error {
-	t := token.Token
+	t, c := token.Token, token.Code
 	token.Token = p.hmacValue(ctx, t)
+	if len(c) > 0 {
+		token.Code = sqlxx.NullString(p.hmacValue(ctx, string(c)))
+	}
 	token.NID = corp.ContextualizeNID(ctx, p.nid)
 
 	// This should not create the request eagerly because otherwise we might accidentally create an address that isn't
@@ -52,7 +56,7 @@ func (p *Persister) CreateVerificationToken(ctx context.Context, token *link.Ver
 	if err := p.GetConnection(ctx).Create(token); err != nil {
 		return err
 	}
-	token.Token = t
+	token.Token, token.Code = t, c
 	return nil
 }
 
@@ -90,6 +94,50 @@ func (p *Persister) UseVerificationToken(ctx context.Context, token string) (*li
 	return &rt, nil
 }
 
+func (p *Persister) UseVerificationCode(ctx context.Context, flowID uuid.UUID, code string) (*link.VerificationToken, error) {
+	var rt link.VerificationToken
+
+	nid := corp.ContextualizeNID(ctx, p.nid)
+	var found bool
+	if err := sqlcon.HandleError(p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) (err error) {
+		for _, secret := range p.r.Config(ctx).SecretsSession() {
+			if err = tx.Where("selfservice_verification_flow_id = ? AND code = ? AND nid = ? AND NOT used AND code_attempts < ?",
+				flowID, p.hmacValueWithSecret(code, secret), nid, link.CodeMaxAttempts).First(&rt); err != nil {
+				if !errors.Is(sqlcon.HandleError(err), sqlcon.ErrNoRows) {
+					return err
+				}
+			} else {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			// Wrong codes count against all codes of the flow as they could otherwise be guessed.
+			/* #nosec G201 TableName is static */
+			return tx.RawQuery(fmt.Sprintf("UPDATE %s SET code_attempts = code_attempts + 1 WHERE selfservice_verification_flow_id = ? AND nid = ? AND code IS NOT NULL AND NOT used", rt.TableName(ctx)), flowID, nid).Exec()
+		}
+
+		var va identity.VerifiableAddress
+		if err := tx.Where("id = ? AND nid = ?", rt.VerifiableAddressID, nid).First(&va); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		rt.VerifiableAddress = &va
+
+		/* #nosec G201 TableName is static */
+		return tx.RawQuery(fmt.Sprintf("UPDATE %s SET used=true, used_at=? WHERE id=? AND nid = ?", rt.TableName(ctx)), time.Now().UTC(), rt.ID, nid).Exec()
+	})); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, sqlcon.ErrNoRows
+	}
+
+	return &rt, nil
+}
+
 func (p *Persister) DeleteVerificationToken(ctx context.Context, token string) error {
 	nid := corp.ContextualizeNID(ctx, p.nid)
 	/* #nosec G201 TableName is static */
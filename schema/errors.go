@@ -59,6 +59,17 @@ func NewTOTPVerifierWrongError(instancePtr string) error {
 	})
 }
 
+func NewCodeInvalidError(instancePtr string) error {
+	t := text.NewErrorValidationCodeInvalid()
+	return errors.WithStack(&ValidationError{
+		ValidationError: &jsonschema.ValidationError{
+			Message:     t.Text,
+			InstancePtr: instancePtr,
+		},
+		Messages: new(text.Messages).Add(t),
+	})
+}
+
 func NewWebAuthnVerifierWrongError(instancePtr string) error {
 	t := text.NewErrorValidationTOTPVerifierWrong()
 	return errors.WithStack(&ValidationError{
@@ -157,6 +168,23 @@ func NewDuplicateCredentialsError() error {
 	})
 }
 
+type ValidationErrorContextDuplicateTraitError struct{}
+
+func (r *ValidationErrorContextDuplicateTraitError) AddContext(_, _ string) {}
+
+func (r *ValidationErrorContextDuplicateTraitError) FinishInstanceContext() {}
+
+func NewDuplicateTraitError(instancePtr, value string) error {
+	return errors.WithStack(&ValidationError{
+		ValidationError: &jsonschema.ValidationError{
+			Message:     fmt.Sprintf("an account with the same value %q exists already", value),
+			InstancePtr: instancePtr,
+			Context:     &ValidationErrorContextDuplicateTraitError{},
+		},
+		Messages: new(text.Messages).Add(text.NewErrorValidationDuplicateTrait(value)),
+	})
+}
+
 func NewNoLoginStrategyResponsible() error {
 	return errors.WithStack(&ValidationError{
 		ValidationError: &jsonschema.ValidationError{
@@ -217,6 +245,16 @@ func NewAddressNotVerifiedError() error {
 	})
 }
 
+func NewIdentityDisabledError() error {
+	return errors.WithStack(&ValidationError{
+		ValidationError: &jsonschema.ValidationError{
+			Message:     `account was disabled`,
+			InstancePtr: "#/",
+		},
+		Messages: new(text.Messages).Add(text.NewErrorValidationIdentityDisabled()),
+	})
+}
+
 func NewNoTOTPDeviceRegistered() error {
 	return errors.WithStack(&ValidationError{
 		ValidationError: &jsonschema.ValidationError{
@@ -7,11 +7,16 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/jsonschema/v3"
+	"github.com/ory/x/jsonschemax"
+
 	"github.com/ory/kratos/embedx"
 )
 
 const (
 	extensionName string = "ory.sh/kratos"
+
+	// ExtensionUnique is the custom property which jsonschemax.ListPaths sets on paths marked as unique.
+	ExtensionUnique = "ory.sh/kratos/unique"
 )
 
 type (
@@ -30,6 +35,7 @@ type (
 		Recovery struct {
 			Via string `json:"via"`
 		} `json:"recovery"`
+		Unique   bool `json:"unique"`
 		Mappings struct {
 			Identity struct {
 				Traits []struct {
@@ -102,6 +108,14 @@ func NewExtensionRunner(runners ...Extension) (*ExtensionRunner, error) {
 	return r, nil
 }
 
+// EnhancePath implements jsonschemax.PathEnhancer.
+func (e *ExtensionConfig) EnhancePath(_ jsonschemax.Path) map[string]interface{} {
+	if !e.Unique {
+		return nil
+	}
+	return map[string]interface{}{ExtensionUnique: true}
+}
+
 func (r *ExtensionRunner) Register(compiler *jsonschema.Compiler) *ExtensionRunner {
 	compiler.Extensions[extensionName] = r.Extension()
 	return r
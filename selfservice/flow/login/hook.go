@@ -10,6 +10,7 @@ import (
 
 	"github.com/ory/kratos/driver/config"
 	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/schema"
 	"github.com/ory/kratos/selfservice/flow"
 	"github.com/ory/kratos/session"
 	"github.com/ory/kratos/x"
@@ -67,9 +68,14 @@ func (e *HookExecutor) requiresAAL2(r *http.Request, s *session.Session) (*sessi
 }
 
 func (e *HookExecutor) PostLoginHook(w http.ResponseWriter, r *http.Request, a *Flow, i *identity.Identity, s *session.Session) error {
+	if !i.IsActive() {
+		return errors.WithStack(schema.NewIdentityDisabledError())
+	}
+
 	if err := s.Activate(i, e.d.Config(r.Context()), time.Now().UTC()); err != nil {
 		return err
 	}
+	s.AddDevice(r, e.d.Config(r.Context()))
 
 	// Verify the redirect URL before we do any other processing.
 	c := e.d.Config(r.Context())
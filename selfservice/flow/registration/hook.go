@@ -146,6 +146,7 @@ func (e *HookExecutor) PostRegistrationHook(w http.ResponseWriter, r *http.Reque
 	if err != nil {
 		return err
 	}
+	s.AddDevice(r, e.d.Config(r.Context()))
 
 	e.d.Logger().
 		WithRequest(r).
@@ -34,6 +34,7 @@ type (
 		errorx.ManagementProvider
 		x.WriterProvider
 		x.LoggingProvider
+		identity.PrivilegedPoolProvider
 
 		HandlerProvider
 		FlowPersistenceProvider
@@ -187,7 +188,21 @@ func (s *ErrorHandler) WriteFlowError(
 		return
 	}
 
-	if err := f.UI.ParseError(group, err); err != nil {
+	code := http.StatusBadRequest
+	if errors.Is(err, identity.ErrConcurrentUpdate) {
+		if id == nil {
+			s.forward(w, r, f, err)
+			return
+		}
+
+		// Render the form again using the identity's current data so that the user
+		// does not overwrite the changes made in the meantime.
+		if err := s.refreshModifiedFlow(w, r, f, id); err != nil {
+			s.forward(w, r, f, err)
+			return
+		}
+		code = http.StatusConflict
+	} else if err := f.UI.ParseError(group, err); err != nil {
 		s.forward(w, r, f, err)
 		return
 	}
@@ -220,7 +235,25 @@ func (s *ErrorHandler) WriteFlowError(
 		s.forward(w, r, updatedFlow, innerErr)
 	}
 
-	s.d.Writer().WriteCode(w, r, x.RecoverStatusCode(err, http.StatusBadRequest), updatedFlow)
+	s.d.Writer().WriteCode(w, r, code, updatedFlow)
+}
+
+func (s *ErrorHandler) refreshModifiedFlow(w http.ResponseWriter, r *http.Request, f *Flow, id *identity.Identity) error {
+	current, err := s.d.PrivilegedIdentityPool().GetIdentityConfidential(r.Context(), id.ID)
+	if err != nil {
+		return err
+	}
+
+	nf, err := s.d.SettingsHandler().FromOldFlow(w, r, current, *f)
+	if err != nil {
+		return err
+	}
+
+	f.UI = nf.UI
+	f.Identity = current
+	f.IdentityVersion = nf.IdentityVersion
+	f.UI.AddMessage(node.DefaultGroup, text.NewErrorValidationSettingsIdentityModified())
+	return nil
 }
 
 func (s *ErrorHandler) forward(w http.ResponseWriter, r *http.Request, rr *Flow, err error) {
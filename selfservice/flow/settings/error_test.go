@@ -180,6 +180,24 @@ func TestHandleError(t *testing.T) {
 				assert.Equal(t, settingsFlow.ID.String(), gjson.GetBytes(body, "id").String())
 			})
 
+			t.Run("case=identity modified error", func(t *testing.T) {
+				t.Cleanup(reset)
+
+				settingsFlow = newFlow(t, time.Minute, tc.t)
+				flowError = errors.WithStack(identity.ErrConcurrentUpdate)
+				flowMethod = settings.StrategyProfile
+
+				res, err := ts.Client().Do(testhelpers.NewHTTPGetJSONRequest(t, ts.URL+"/error"))
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusConflict, res.StatusCode)
+
+				body, err := ioutil.ReadAll(res.Body)
+				require.NoError(t, err)
+				assert.Equal(t, int(text.ErrorValidationSettingsIdentityModified), int(gjson.GetBytes(body, "ui.messages.0.id").Int()), "%s", body)
+				assert.Equal(t, settingsFlow.ID.String(), gjson.GetBytes(body, "id").String())
+			})
+
 			t.Run("case=return to UI error", func(t *testing.T) {
 				t.Cleanup(reset)
 
@@ -99,6 +99,10 @@ type Flow struct {
 	// InternalContext stores internal context used by internals - for example MFA keys.
 	InternalContext sqlxx.JSONRawMessage `db:"internal_context" json:"-" faker:"-"`
 
+	// IdentityVersion is the identity's version when the flow's form was rendered. It is used to
+	// detect whether the identity was modified by someone else before the form was submitted.
+	IdentityVersion int `json:"-" faker:"-" db:"identity_version"`
+
 	// IdentityID is a helper struct field for gobuffalo.pop.
 	IdentityID uuid.UUID `json:"-" faker:"-" db:"identity_id"`
 	// CreatedAt is a helper struct field for gobuffalo.pop.
@@ -133,14 +137,15 @@ func NewFlow(conf *config.Config, exp time.Duration, r *http.Request, i *identit
 	}
 
 	return &Flow{
-		ID:         id,
-		ExpiresAt:  now.Add(exp),
-		IssuedAt:   now,
-		RequestURL: requestURL,
-		IdentityID: i.ID,
-		Identity:   i,
-		Type:       ft,
-		State:      StateShowForm,
+		ID:              id,
+		ExpiresAt:       now.Add(exp),
+		IssuedAt:        now,
+		RequestURL:      requestURL,
+		IdentityID:      i.ID,
+		Identity:        i,
+		IdentityVersion: i.Version,
+		Type:            ft,
+		State:           StateShowForm,
 		UI: &container.Container{
 			Method: "POST",
 			Action: flow.AppendFlowTo(urlx.AppendPaths(conf.SelfPublicURL(r), RouteSubmitFlow), id).String(),
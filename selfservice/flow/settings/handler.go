@@ -427,6 +427,8 @@ type submitSelfServiceSettingsFlowBody struct{}
 //   - HTTP 401 when the endpoint is called without a valid session token.
 //   - HTTP 403 when `selfservice.flows.settings.privileged_session_max_age` was reached or the session's AAL is too low.
 //     Implies that the user needs to re-authenticate.
+//   - HTTP 409 when the identity was modified after the flow was initialized. The flow is returned with the identity's
+//     current data and needs to be submitted again.
 //
 // Browser flows without HTTP Header `Accept` or with `Accept: text/*` respond with
 //   - a HTTP 302 redirect to the post/after settings URL or the `return_to` value if it was set and if the flow succeeded;
@@ -439,6 +441,8 @@ type submitSelfServiceSettingsFlowBody struct{}
 //   - HTTP 401 when the endpoint is called without a valid session cookie.
 //   - HTTP 403 when the page is accessed without a session cookie or the session's AAL is too low.
 //   - HTTP 400 on form validation errors.
+//   - HTTP 409 when the identity was modified after the flow was initialized. The flow is returned with the identity's
+//     current data and needs to be submitted again.
 //
 // Depending on your configuration this endpoint might return a 403 error if the session has a lower Authenticator
 // Assurance Level (AAL) than is possible for the identity. This can happen if the identity has password + webauthn
@@ -479,6 +483,7 @@ type submitSelfServiceSettingsFlowBody struct{}
 //       400: selfServiceSettingsFlow
 //       401: jsonError
 //       403: jsonError
+//       409: selfServiceSettingsFlow
 //       422: selfServiceBrowserLocationChangeRequiredError
 //       500: jsonError
 func (h *Handler) submitSettingsFlow(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -141,13 +141,18 @@ func (e *HookExecutor) PostSettingsHook(w http.ResponseWriter, r *http.Request,
 		e.d.Logger().WithRequest(r).WithFields(logFields).Debug("ExecuteSettingsPrePersistHook completed successfully.")
 	}
 
-	options := []identity.ManagerOption{identity.ManagerExposeValidationErrorsForInternalTypeAssertion}
+	// The identity must not have been modified since the flow's form was rendered.
+	options := []identity.ManagerOption{
+		identity.ManagerExposeValidationErrorsForInternalTypeAssertion,
+		identity.ManagerExpectVersion(ctxUpdate.Flow.IdentityVersion),
+	}
 	ttl := e.d.Config(r.Context()).SelfServiceFlowSettingsPrivilegedSessionMaxAge()
 	if ctxUpdate.Session.AuthenticatedAt.Add(ttl).After(time.Now()) {
 		options = append(options, identity.ManagerAllowWriteProtectedTraits)
 	}
 
-	if err := e.d.IdentityManager().Update(r.Context(), i, options...); err != nil {
+	ctx := identity.WithHistoryCause(r.Context(), identity.HistoryCauseSettingsFlow)
+	if err := e.d.IdentityManager().Update(ctx, i, options...); err != nil {
 		if errors.Is(err, identity.ErrProtectedFieldModified) {
 			e.d.Logger().WithError(err).Debug("Modifying protected field requires re-authentication.")
 			return errors.WithStack(NewFlowNeedsReAuth())
@@ -176,6 +181,7 @@ func (e *HookExecutor) PostSettingsHook(w http.ResponseWriter, r *http.Request,
 	}
 
 	ctxUpdate.Flow.UI = newFlow.UI
+	ctxUpdate.Flow.IdentityVersion = newFlow.IdentityVersion
 	ctxUpdate.Flow.UI.ResetMessages()
 	ctxUpdate.Flow.UI.AddMessage(node.DefaultGroup, text.NewInfoSelfServiceSettingsUpdateSuccess())
 	if err := e.d.SettingsFlowPersister().UpdateSettingsFlow(r.Context(), ctxUpdate.Flow); err != nil {
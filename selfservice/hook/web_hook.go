@@ -73,6 +73,18 @@ type (
 	}
 )
 
+// MarshalJSON exposes the identity's admin metadata to the web hook's Jsonnet template.
+func (t templateContext) MarshalJSON() ([]byte, error) {
+	type localContext templateContext
+	return json.Marshal(&struct {
+		localContext
+		Identity *identity.WithAdminMetadataInJSON `json:"identity"`
+	}{
+		localContext: localContext(t),
+		Identity:     (*identity.WithAdminMetadataInJSON)(t.Identity),
+	})
+}
+
 var strategyFactories = map[string]authStrategyFactory{
 	"":           newNoopAuthStrategy,
 	"api_key":    newApiKeyStrategy,
@@ -169,6 +169,20 @@ func TestJsonNetSupport(t *testing.T) {
 		})
 	}
 
+	t.Run("case=exposes the identity's metadata", func(t *testing.T) {
+		i := identity.NewIdentity("")
+		i.MetadataPublic = []byte(`{"plan":"pro"}`)
+		i.MetadataAdmin = []byte(`{"support":"priority"}`)
+
+		template := "base64://" + base64.StdEncoding.EncodeToString([]byte(`function(ctx) { public: ctx.identity.metadata_public, admin: ctx.identity.metadata_admin }`))
+		b, err := createBody(l, template, &templateContext{Flow: f, Identity: i})
+		require.NoError(t, err)
+		body, err := io.ReadAll(b)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"public":{"plan":"pro"},"admin":{"support":"priority"}}`, string(body))
+	})
+
 	t.Run("case=warns about legacy usage", func(t *testing.T) {
 		hook := test.Hook{}
 		l := logrusx.New("kratos", "test", logrusx.WithHook(&hook))
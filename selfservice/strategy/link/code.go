@@ -0,0 +1,41 @@
+package link
+
+import (
+	"net/http"
+
+	"github.com/ory/x/randx"
+	"github.com/ory/x/sqlxx"
+
+	"github.com/ory/kratos/text"
+	"github.com/ory/kratos/ui/node"
+)
+
+const (
+	// codeLength is the number of digits of the one-time codes which are sent to phone numbers.
+	codeLength = 6
+
+	// CodeMaxAttempts is the number of wrong codes which may be submitted for a flow before its codes become
+	// invalid and a new code has to be requested.
+	CodeMaxAttempts = 5
+)
+
+func newCode() sqlxx.NullString {
+	return sqlxx.NullString(randx.MustString(codeLength, randx.Numeric))
+}
+
+// upsertAddressNodes sets the address fields of a recovery or verification form. If SMS are enabled, either an email
+// address or a phone number may be provided, which is why neither field is required in that case.
+func (s *Strategy) upsertAddressNodes(r *http.Request, nodes *node.Nodes, group node.Group, email, phone string) {
+	if !s.d.Config(r.Context()).CourierSMSEnabled() {
+		nodes.Upsert(node.NewInputField("email", email, group, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute))
+		return
+	}
+
+	nodes.Upsert(node.NewInputField("email", email, group, node.InputAttributeTypeEmail).WithMetaLabel(text.NewInfoNodeInputEmail()))
+	nodes.Upsert(node.NewInputField("phone", phone, group, node.InputAttributeTypeTel).WithMetaLabel(text.NewInfoNodeInputPhone()))
+}
+
+// upsertCodeNode adds the field for the code which was sent to the phone number.
+func upsertCodeNode(nodes *node.Nodes, group node.Group) {
+	nodes.Upsert(node.NewInputField("code", nil, group, node.InputAttributeTypeText, node.WithRequiredInputAttribute).WithMetaLabel(text.NewInfoNodeInputCode()))
+}
@@ -2,12 +2,15 @@ package link
 
 import (
 	"context"
+
+	"github.com/gofrs/uuid"
 )
 
 type (
 	RecoveryTokenPersister interface {
 		CreateRecoveryToken(ctx context.Context, token *RecoveryToken) error
 		UseRecoveryToken(ctx context.Context, token string) (*RecoveryToken, error)
+		UseRecoveryCode(ctx context.Context, flowID uuid.UUID, code string) (*RecoveryToken, error)
 		DeleteRecoveryToken(ctx context.Context, token string) error
 	}
 
@@ -18,6 +21,7 @@ type (
 	VerificationTokenPersister interface {
 		CreateVerificationToken(ctx context.Context, token *VerificationToken) error
 		UseVerificationToken(ctx context.Context, token string) (*VerificationToken, error)
+		UseVerificationCode(ctx context.Context, flowID uuid.UUID, code string) (*VerificationToken, error)
 		DeleteVerificationToken(ctx context.Context, token string) error
 	}
 
@@ -50,15 +50,18 @@ func NewSender(r senderDependencies) *Sender {
 
 // SendRecoveryLink sends a recovery link to the specified address. If the address does not exist in the store, an email is
 // still being sent to prevent account enumeration attacks. In that case, this function returns the ErrUnknownAddress
-// error.
+// error. No SMS is sent to unknown phone numbers as anyone could otherwise use the endpoint to send arbitrary SMS.
 func (s *Sender) SendRecoveryLink(ctx context.Context, r *http.Request, f *recovery.Flow, via identity.VerifiableAddressType, to string) error {
 	s.r.Logger().
 		WithField("via", via).
 		WithSensitiveField("address", to).
 		Debug("Preparing verification code.")
 
-	address, err := s.r.IdentityPool().FindRecoveryAddressByValue(ctx, identity.RecoveryAddressTypeEmail, to)
+	address, err := s.r.IdentityPool().FindRecoveryAddressByValue(ctx, identity.RecoveryAddressType(via), to)
 	if err != nil {
+		if via == identity.VerifiableAddressTypeSMS {
+			return errors.Cause(ErrUnknownAddress)
+		}
 		if err := s.send(ctx, string(via), templates.NewRecoveryInvalid(s.r.Config(ctx), &templates.RecoveryInvalidModel{To: to})); err != nil {
 			return err
 		}
@@ -85,7 +88,7 @@ func (s *Sender) SendRecoveryLink(ctx context.Context, r *http.Request, f *recov
 
 // SendVerificationLink sends a verification link to the specified address. If the address does not exist in the store, an email is
 // still being sent to prevent account enumeration attacks. In that case, this function returns the ErrUnknownAddress
-// error.
+// error. No SMS is sent to unknown phone numbers as anyone could otherwise use the endpoint to send arbitrary SMS.
 func (s *Sender) SendVerificationLink(ctx context.Context, f *verification.Flow, via identity.VerifiableAddressType, to string) error {
 	s.r.Logger().
 		WithField("via", via).
@@ -95,6 +98,10 @@ func (s *Sender) SendVerificationLink(ctx context.Context, f *verification.Flow,
 	address, err := s.r.IdentityPool().FindVerifiableAddressByValue(ctx, via, to)
 	if err != nil {
 		if errorsx.Cause(err) == sqlcon.ErrNoRows {
+			if via == identity.VerifiableAddressTypeSMS {
+				return errors.Cause(ErrUnknownAddress)
+			}
+
 			s.r.Audit().
 				WithField("via", via).
 				WithSensitiveField("email_address", address).
@@ -125,6 +132,10 @@ func (s *Sender) SendVerificationLink(ctx context.Context, f *verification.Flow,
 }
 
 func (s *Sender) SendRecoveryTokenTo(ctx context.Context, f *recovery.Flow, i *identity.Identity, address *identity.RecoveryAddress, token *RecoveryToken) error {
+	if address.Via == identity.RecoveryAddressTypeSMS {
+		return s.sendRecoveryCodeTo(ctx, f, i, address, token)
+	}
+
 	s.r.Audit().
 		WithField("via", address.Via).
 		WithField("identity_id", address.IdentityID).
@@ -147,7 +158,69 @@ func (s *Sender) SendRecoveryTokenTo(ctx context.Context, f *recovery.Flow, i *i
 			}).String(), Identity: model}))
 }
 
+func (s *Sender) sendRecoveryCodeTo(ctx context.Context, f *recovery.Flow, i *identity.Identity, address *identity.RecoveryAddress, token *RecoveryToken) error {
+	s.r.Audit().
+		WithField("via", address.Via).
+		WithField("identity_id", address.IdentityID).
+		WithField("recovery_link_id", token.ID).
+		WithSensitiveField("phone_number", address.Value).
+		Info("Sending out recovery SMS with recovery code.")
+
+	model, err := x.StructToMap(i)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.r.Courier(ctx).QueueSMS(ctx, templates.NewRecoveryValidSMS(s.r.Config(ctx),
+		&templates.RecoveryValidSMSModel{
+			To:          address.Value,
+			Code:        string(token.Code),
+			RecoveryURL: f.AppendTo(s.r.Config(ctx).SelfServiceFlowRecoveryUI()).String(),
+			Identity:    model,
+		}))
+	return err
+}
+
 func (s *Sender) SendVerificationTokenTo(ctx context.Context, f *verification.Flow, i *identity.Identity, address *identity.VerifiableAddress, token *VerificationToken) error {
+	if address.Via == identity.VerifiableAddressTypeSMS {
+		if err := s.sendVerificationCodeTo(ctx, f, i, address, token); err != nil {
+			return err
+		}
+	} else if err := s.sendVerificationLinkTo(ctx, f, i, address, token); err != nil {
+		return err
+	}
+
+	address.Status = identity.VerifiableAddressStatusSent
+	if err := s.r.PrivilegedIdentityPool().UpdateVerifiableAddress(ctx, address); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Sender) sendVerificationCodeTo(ctx context.Context, f *verification.Flow, i *identity.Identity, address *identity.VerifiableAddress, token *VerificationToken) error {
+	s.r.Audit().
+		WithField("via", address.Via).
+		WithField("identity_id", address.IdentityID).
+		WithField("verification_link_id", token.ID).
+		WithSensitiveField("phone_number", address.Value).
+		Info("Sending out verification SMS with verification code.")
+
+	model, err := x.StructToMap(i)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.r.Courier(ctx).QueueSMS(ctx, templates.NewVerificationValidSMS(s.r.Config(ctx),
+		&templates.VerificationValidSMSModel{
+			To:              address.Value,
+			Code:            string(token.Code),
+			VerificationURL: f.AppendTo(s.r.Config(ctx).SelfServiceFlowVerificationUI()).String(),
+			Identity:        model,
+		}))
+	return err
+}
+
+func (s *Sender) sendVerificationLinkTo(ctx context.Context, f *verification.Flow, i *identity.Identity, address *identity.VerifiableAddress, token *VerificationToken) error {
 	s.r.Audit().
 		WithField("via", address.Via).
 		WithField("identity_id", address.IdentityID).
@@ -161,20 +234,13 @@ func (s *Sender) SendVerificationTokenTo(ctx context.Context, f *verification.Fl
 		return err
 	}
 
-	if err := s.send(ctx, string(address.Via), templates.NewVerificationValid(s.r.Config(ctx),
+	return s.send(ctx, string(address.Via), templates.NewVerificationValid(s.r.Config(ctx),
 		&templates.VerificationValidModel{To: address.Value, VerificationURL: urlx.CopyWithQuery(
 			urlx.AppendPaths(s.r.Config(ctx).SelfPublicURL(nil), verification.RouteSubmitFlow),
 			url.Values{
 				"flow":  {f.ID.String()},
 				"token": {token.Token},
-			}).String(), Identity: model})); err != nil {
-		return err
-	}
-	address.Status = identity.VerifiableAddressStatusSent
-	if err := s.r.PrivilegedIdentityPool().UpdateVerifiableAddress(ctx, address); err != nil {
-		return err
-	}
-	return nil
+			}).String(), Identity: model}))
 }
 
 func (s *Sender) send(ctx context.Context, via string, t courier.EmailTemplate) error {
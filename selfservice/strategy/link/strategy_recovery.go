@@ -47,10 +47,14 @@ func (s *Strategy) RegisterAdminRecoveryRoutes(admin *x.RouterAdmin) {
 
 func (s *Strategy) PopulateRecoveryMethod(r *http.Request, f *recovery.Flow) error {
 	f.UI.SetCSRF(s.d.GenerateCSRFToken(r))
-	f.UI.GetNodes().Upsert(
-		// v0.5: form.Field{Name: "email", Type: "email", Required: true},
-		node.NewInputField("email", nil, node.RecoveryLinkGroup, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute).WithMetaLabel(text.NewInfoNodeInputEmail()),
-	)
+	if s.d.Config(r.Context()).CourierSMSEnabled() {
+		s.upsertAddressNodes(r, f.UI.GetNodes(), node.RecoveryLinkGroup, "", "")
+	} else {
+		f.UI.GetNodes().Upsert(
+			// v0.5: form.Field{Name: "email", Type: "email", Required: true},
+			node.NewInputField("email", nil, node.RecoveryLinkGroup, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute).WithMetaLabel(text.NewInfoNodeInputEmail()),
+		)
+	}
 	f.UI.GetNodes().Append(node.NewInputField("method", s.RecoveryStrategyID(), node.RecoveryLinkGroup, node.InputAttributeTypeSubmit).WithMetaLabel(text.NewInfoNodeLabelSubmit()))
 
 	return nil
@@ -194,10 +198,24 @@ type submitSelfServiceRecoveryFlowWithLinkMethodBody struct {
 	// recovery email, a recovery link will be sent. If the email is not known,
 	// a email with details on what happened will be sent instead.
 	//
+	// Can be omitted in favor of `phone` if `courier.sms.enabled` is true.
+	//
 	// format: email
 	// required: true
 	Email string `json:"email" form:"email"`
 
+	// Phone Number to Recover
+	//
+	// Can be set instead of `email` when initiating the flow if `courier.sms.enabled` is true. If the phone
+	// number is a registered recovery address, an SMS containing a code will be sent. No SMS is sent
+	// to unknown phone numbers.
+	Phone string `json:"phone" form:"phone"`
+
+	// Code sent via SMS
+	//
+	// Completes the flow if it is the code which was sent to the phone number.
+	Code string `json:"code" form:"code"`
+
 	// Sending the anti-csrf token is only required for browser login flows.
 	CSRFToken string `form:"csrf_token" json:"csrf_token"`
 
@@ -234,6 +252,10 @@ func (s *Strategy) Recover(w http.ResponseWriter, r *http.Request, f *recovery.F
 		return s.HandleRecoveryError(w, r, req, body, err)
 	}
 
+	if len(body.Code) > 0 {
+		return s.recoveryUseCode(w, r, req, body)
+	}
+
 	switch req.State {
 	case recovery.StateChooseMethod:
 		fallthrough
@@ -263,6 +285,7 @@ func (s *Strategy) recoveryIssueSession(w http.ResponseWriter, r *http.Request,
 	if err != nil {
 		return s.retryRecoveryFlowWithError(w, r, flow.TypeBrowser, err)
 	}
+	sess.AddDevice(r, s.d.Config(r.Context()))
 
 	if err := s.d.SessionManager().UpsertAndIssueCookie(r.Context(), w, r, sess); err != nil {
 		return s.retryRecoveryFlowWithError(w, r, flow.TypeBrowser, err)
@@ -328,6 +351,28 @@ func (s *Strategy) recoveryUseToken(w http.ResponseWriter, r *http.Request, body
 		}
 	}
 
+	return s.recoveryComplete(w, r, f, body, token)
+}
+
+func (s *Strategy) recoveryUseCode(w http.ResponseWriter, r *http.Request, f *recovery.Flow, body *recoverySubmitPayload) error {
+	if err := flow.EnsureCSRF(s.d, r, f.Type, s.d.Config(r.Context()).DisableAPIFlowEnforcement(), s.d.GenerateCSRFToken, body.CSRFToken); err != nil {
+		return s.HandleRecoveryError(w, r, f, body, err)
+	}
+
+	token, err := s.d.RecoveryTokenPersister().UseRecoveryCode(r.Context(), f.ID, body.Code)
+	if err != nil {
+		if errors.Is(err, sqlcon.ErrNoRows) {
+			return s.HandleRecoveryError(w, r, f, body, schema.NewCodeInvalidError("#/code"))
+		}
+
+		return s.HandleRecoveryError(w, r, f, body, err)
+	}
+
+	return s.recoveryComplete(w, r, f, body, token)
+}
+
+// recoveryComplete issues a session for the identity the token belongs to.
+func (s *Strategy) recoveryComplete(w http.ResponseWriter, r *http.Request, f *recovery.Flow, body *recoverySubmitPayload, token *RecoveryToken) error {
 	if err := token.Valid(); err != nil {
 		return s.retryRecoveryFlowWithError(w, r, flow.TypeBrowser, err)
 	}
@@ -406,6 +451,10 @@ func (s *Strategy) recoveryHandleFormSubmission(w http.ResponseWriter, r *http.R
 		return s.HandleRecoveryError(w, r, f, body, err)
 	}
 
+	if len(body.Phone) > 0 && s.d.Config(r.Context()).CourierSMSEnabled() {
+		return s.recoveryHandlePhoneSubmission(w, r, f, body)
+	}
+
 	if len(body.Email) == 0 {
 		return s.HandleRecoveryError(w, r, f, body, schema.NewRequiredError("#/email", "email"))
 	}
@@ -437,6 +486,37 @@ func (s *Strategy) recoveryHandleFormSubmission(w http.ResponseWriter, r *http.R
 	return nil
 }
 
+func (s *Strategy) recoveryHandlePhoneSubmission(w http.ResponseWriter, r *http.Request, f *recovery.Flow, body *recoverySubmitPayload) error {
+	phone, err := identity.NormalizePhoneNumber(body.Phone)
+	if err != nil {
+		return s.HandleRecoveryError(w, r, f, body, schema.NewInvalidFormatError("#/phone", "tel", body.Phone))
+	}
+
+	if err := flow.EnsureCSRF(s.d, r, f.Type, s.d.Config(r.Context()).DisableAPIFlowEnforcement(), s.d.GenerateCSRFToken, body.CSRFToken); err != nil {
+		return s.HandleRecoveryError(w, r, f, body, err)
+	}
+
+	if err := s.d.LinkSender().SendRecoveryLink(r.Context(), r, f, identity.VerifiableAddressTypeSMS, phone); err != nil {
+		if !errors.Is(err, ErrUnknownAddress) {
+			return s.HandleRecoveryError(w, r, f, body, err)
+		}
+		// Continue execution
+	}
+
+	f.UI.SetCSRF(s.d.GenerateCSRFToken(r))
+	s.upsertAddressNodes(r, f.UI.GetNodes(), node.RecoveryLinkGroup, "", phone)
+	upsertCodeNode(f.UI.GetNodes(), node.RecoveryLinkGroup)
+
+	f.Active = sqlxx.NullString(s.RecoveryNodeGroup())
+	f.State = recovery.StateEmailSent
+	f.UI.Messages.Set(text.NewRecoverySMSSent())
+	if err := s.d.RecoveryFlowPersister().UpdateRecoveryFlow(r.Context(), f); err != nil {
+		return s.HandleRecoveryError(w, r, f, body, err)
+	}
+
+	return nil
+}
+
 func (s *Strategy) markRecoveryAddressVerified(w http.ResponseWriter, r *http.Request, f *recovery.Flow, id *identity.Identity, recoveryAddress *identity.RecoveryAddress) error {
 	var address *identity.VerifiableAddress
 	for idx := range id.VerifiableAddresses {
@@ -462,16 +542,16 @@ func (s *Strategy) markRecoveryAddressVerified(w http.ResponseWriter, r *http.Re
 
 func (s *Strategy) HandleRecoveryError(w http.ResponseWriter, r *http.Request, req *recovery.Flow, body *recoverySubmitPayload, err error) error {
 	if req != nil {
-		email := ""
+		var email, phone string
 		if body != nil {
-			email = body.Email
+			email, phone = body.Email, body.Phone
 		}
 
 		req.UI.SetCSRF(s.d.GenerateCSRFToken(r))
-		req.UI.GetNodes().Upsert(
-			// v0.5: form.Field{Name: "email", Type: "email", Required: true, Value: body.Body.Email}
-			node.NewInputField("email", email, node.RecoveryLinkGroup, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute),
-		)
+		s.upsertAddressNodes(r, req.UI.GetNodes(), node.RecoveryLinkGroup, email, phone)
+		if body != nil && len(body.Code) > 0 {
+			upsertCodeNode(req.UI.GetNodes(), node.RecoveryLinkGroup)
+		}
 	}
 
 	return err
@@ -483,6 +563,8 @@ type recoverySubmitPayload struct {
 	CSRFToken string `json:"csrf_token" form:"csrf_token"`
 	Flow      string `json:"flow" form:"flow"`
 	Email     string `json:"email" form:"email"`
+	Phone     string `json:"phone" form:"phone"`
+	Code      string `json:"code" form:"code"`
 }
 
 func (s *Strategy) decodeRecovery(r *http.Request) (*recoverySubmitPayload, error) {
@@ -594,6 +594,89 @@ func TestRecovery(t *testing.T) {
 	})
 }
 
+func TestRecoveryWithSMS(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	initViper(t, conf)
+	conf.MustSet(config.ViperKeyDefaultIdentitySchemaURL, "file://./stub/phone.schema.json")
+	conf.MustSet(config.ViperKeyCourierSMSEnabled, true)
+	conf.MustSet(config.ViperKeyCourierSMSRequestConfig, map[string]interface{}{"url": "https://sms.example.org/send"})
+
+	_ = testhelpers.NewRecoveryUIFlowEchoServer(t, reg)
+	_ = testhelpers.NewLoginUIFlowEchoServer(t, reg)
+	_ = testhelpers.NewSettingsUIFlowEchoServer(t, reg)
+	_ = testhelpers.NewErrorTestServer(t, reg)
+
+	public, _ := testhelpers.NewKratosServerWithCSRF(t, reg)
+
+	phone := "+4917687654321"
+	id := &identity.Identity{Traits: identity.Traits(`{"email":"recover-sms@ory.sh","phone":"+49 176 87654321"}`)}
+	require.NoError(t, reg.IdentityManager().Create(context.Background(), id, identity.ManagerAllowWriteProtectedTraits))
+
+	var submitCode = func(t *testing.T, hc *http.Client, flow, code string) (string, *http.Response) {
+		res, err := hc.PostForm(gjson.Get(flow, "ui.action").String(), url.Values{
+			"method":     {"link"},
+			"code":       {code},
+			"csrf_token": {gjson.Get(flow, "ui.nodes.#(attributes.name==csrf_token).attributes.value").String()},
+		})
+		require.NoError(t, err)
+		defer res.Body.Close()
+		return string(ioutilx.MustReadAll(res.Body)), res
+	}
+
+	t.Run("description=should recover an account using the code", func(t *testing.T) {
+		hc := testhelpers.NewClientWithCookies(t)
+		body := testhelpers.SubmitRecoveryForm(t, false, false, hc, public, func(v url.Values) {
+			v.Del("email")
+			v.Set("phone", "+49 (176) 876-54321")
+		}, http.StatusOK, conf.SelfServiceFlowRecoveryUI().String())
+		assert.EqualValues(t, phone, gjson.Get(body, "ui.nodes.#(attributes.name==phone).attributes.value").String(), "%s", body)
+		assertx.EqualAsJSON(t, text.NewRecoverySMSSent(), json.RawMessage(gjson.Get(body, "ui.messages.0").Raw))
+
+		message, err := reg.CourierPersister().LatestQueuedMessage(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, phone, message.Recipient)
+		assert.Contains(t, message.Body, conf.SelfServiceFlowRecoveryUI().String())
+		code := testhelpers.CourierExpectCodeInMessage(t, message)
+
+		actual, _ := submitCode(t, hc, body, "not-"+code)
+		assert.Equal(t, text.NewErrorValidationCodeInvalid().Text, gjson.Get(actual, "ui.nodes.#(attributes.name==code).messages.0.text").String(), "%s", actual)
+
+		actual, res := submitCode(t, hc, actual, code)
+		assert.Contains(t, res.Request.URL.String(), conf.SelfServiceFlowSettingsUI().String(), "%s", actual)
+		assert.Equal(t, text.NewRecoverySuccessful(time.Now().Add(time.Hour)).Text, gjson.Get(actual, "ui.messages.0.text").String(), "%s", actual)
+
+		address, err := reg.IdentityPool().FindVerifiableAddressByValue(context.Background(), identity.VerifiableAddressTypeSMS, phone)
+		require.NoError(t, err)
+		assert.True(t, address.Verified)
+
+		res, err = hc.Get(public.URL + session.RouteWhoami)
+		require.NoError(t, err)
+		whoami := x.MustReadAll(res.Body)
+		require.NoError(t, res.Body.Close())
+		assert.Equal(t, id.ID.String(), gjson.GetBytes(whoami, "identity.id").String(), "%s", whoami)
+	})
+
+	t.Run("description=should invalidate the code after too many attempts", func(t *testing.T) {
+		hc := testhelpers.NewClientWithCookies(t)
+		body := testhelpers.SubmitRecoveryForm(t, false, false, hc, public, func(v url.Values) {
+			v.Del("email")
+			v.Set("phone", phone)
+		}, http.StatusOK, conf.SelfServiceFlowRecoveryUI().String())
+
+		message, err := reg.CourierPersister().LatestQueuedMessage(context.Background())
+		require.NoError(t, err)
+		code := testhelpers.CourierExpectCodeInMessage(t, message)
+
+		for i := 0; i < link.CodeMaxAttempts; i++ {
+			body, _ = submitCode(t, hc, body, "not-"+code)
+		}
+
+		actual, res := submitCode(t, hc, body, code)
+		assert.Contains(t, res.Request.URL.String(), conf.SelfServiceFlowRecoveryUI().String(), "%s", actual)
+		assert.Equal(t, text.NewErrorValidationCodeInvalid().Text, gjson.Get(actual, "ui.nodes.#(attributes.name==code).messages.0.text").String(), "%s", actual)
+	})
+}
+
 func TestDisabledEndpoint(t *testing.T) {
 	conf, reg := internal.NewFastRegistryWithMocks(t)
 	initViper(t, conf)
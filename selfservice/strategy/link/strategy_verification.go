@@ -5,12 +5,16 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
+	"github.com/ory/herodot"
+
 	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/schema"
 	"github.com/ory/kratos/selfservice/flow"
 	"github.com/ory/kratos/selfservice/flow/verification"
+	"github.com/ory/kratos/selfservice/strategy"
 	"github.com/ory/kratos/text"
 	"github.com/ory/kratos/ui/node"
 	"github.com/ory/kratos/x"
@@ -20,22 +24,102 @@ import (
 	"github.com/ory/x/urlx"
 )
 
+const (
+	RouteAdminSendVerificationLink = identity.RouteVerifiableAddress + "/verification"
+)
+
 func (s *Strategy) VerificationStrategyID() string {
 	return verification.StrategyVerificationLinkName
 }
 
 func (s *Strategy) RegisterPublicVerificationRoutes(public *x.RouterPublic) {
+	s.d.CSRFHandler().IgnoreGlob(identity.RouteVerifiableAddresses + "/*/verification")
+	public.POST(RouteAdminSendVerificationLink, x.RedirectToAdminRoute(s.d))
 }
 
 func (s *Strategy) RegisterAdminVerificationRoutes(admin *x.RouterAdmin) {
+	admin.POST(RouteAdminSendVerificationLink, strategy.IsVerificationDisabled(s.d, s.VerificationStrategyID(), s.sendVerificationLink))
+}
+
+// swagger:parameters adminSendSelfServiceVerificationLink
+// nolint:deadcode,unused
+type adminSendSelfServiceVerificationLink struct {
+	// ID is the verifiable address' ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+}
+
+// swagger:route POST /verifiable-addresses/{id}/verification v0alpha2 adminSendSelfServiceVerificationLink
+//
+// Send a Verification Link
+//
+// This endpoint sends a verification link to a verifiable address which is not verified yet, for example if the
+// user did not receive the original email. Following the link completes a new verification flow.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       204: emptyResponse
+//       400: jsonError
+//       404: jsonError
+//       500: jsonError
+func (s *Strategy) sendVerificationLink(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	address, err := s.d.PrivilegedIdentityPool().GetVerifiableAddress(r.Context(), x.ParseUUID(ps.ByName("id")))
+	if err != nil {
+		s.d.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if address.Verified {
+		s.d.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("The address is already verified.")))
+		return
+	}
+
+	f, err := verification.NewFlow(s.d.Config(r.Context()), s.d.Config(r.Context()).SelfServiceFlowVerificationRequestLifespan(),
+		s.d.GenerateCSRFToken(r), r, s.d.VerificationStrategies(r.Context()), flow.TypeBrowser)
+	if err != nil {
+		s.d.Writer().WriteError(w, r, err)
+		return
+	}
+
+	f.Active = sqlxx.NullString(s.VerificationNodeGroup())
+	f.State = verification.StateEmailSent
+	if err := s.d.VerificationFlowPersister().CreateVerificationFlow(r.Context(), f); err != nil {
+		s.d.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := s.d.LinkSender().SendVerificationLink(r.Context(), f, address.Via, address.Value); err != nil {
+		s.d.Writer().WriteError(w, r, err)
+		return
+	}
+
+	s.d.Audit().
+		WithField("identity_id", address.IdentityID).
+		WithSensitiveField("address", address.Value).
+		Info("A verification link has been sent by an administrator.")
+
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func (s *Strategy) PopulateVerificationMethod(r *http.Request, f *verification.Flow) error {
 	f.UI.SetCSRF(s.d.GenerateCSRFToken(r))
-	f.UI.GetNodes().Upsert(
-		// v0.5: form.Field{Name: "email", Type: "email", Required: true}
-		node.NewInputField("email", nil, node.VerificationLinkGroup, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute).WithMetaLabel(text.NewInfoNodeInputEmail()),
-	)
+	if s.d.Config(r.Context()).CourierSMSEnabled() {
+		s.upsertAddressNodes(r, f.UI.GetNodes(), node.VerificationLinkGroup, "", "")
+	} else {
+		f.UI.GetNodes().Upsert(
+			// v0.5: form.Field{Name: "email", Type: "email", Required: true}
+			node.NewInputField("email", nil, node.VerificationLinkGroup, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute).WithMetaLabel(text.NewInfoNodeInputEmail()),
+		)
+	}
 	f.UI.GetNodes().Append(node.NewInputField("method", s.VerificationStrategyID(), node.VerificationLinkGroup, node.InputAttributeTypeSubmit).WithMetaLabel(text.NewInfoNodeLabelSubmit()))
 	return nil
 }
@@ -46,6 +130,8 @@ type verificationSubmitPayload struct {
 	CSRFToken string `json:"csrf_token" form:"csrf_token"`
 	Flow      string `json:"flow" form:"flow"`
 	Email     string `json:"email" form:"email"`
+	Phone     string `json:"phone" form:"phone"`
+	Code      string `json:"code" form:"code"`
 }
 
 func (s *Strategy) decodeVerification(r *http.Request) (*verificationSubmitPayload, error) {
@@ -72,11 +158,16 @@ func (s *Strategy) decodeVerification(r *http.Request) (*verificationSubmitPaylo
 // handleVerificationError is a convenience function for handling all types of errors that may occur (e.g. validation error).
 func (s *Strategy) handleVerificationError(w http.ResponseWriter, r *http.Request, f *verification.Flow, body *verificationSubmitPayload, err error) error {
 	if f != nil {
+		var email, phone string
+		if body != nil {
+			email, phone = body.Email, body.Phone
+		}
+
 		f.UI.SetCSRF(s.d.GenerateCSRFToken(r))
-		f.UI.GetNodes().Upsert(
-			// v0.5: form.Field{Name: "email", Type: "email", Required: true, Value: body.Body.Email}
-			node.NewInputField("email", body.Email, node.VerificationLinkGroup, node.InputAttributeTypeEmail, node.WithRequiredInputAttribute),
-		)
+		s.upsertAddressNodes(r, f.UI.GetNodes(), node.VerificationLinkGroup, email, phone)
+		if body != nil && len(body.Code) > 0 {
+			upsertCodeNode(f.UI.GetNodes(), node.VerificationLinkGroup)
+		}
 	}
 
 	return err
@@ -91,10 +182,24 @@ type submitSelfServiceVerificationFlowWithLinkMethodBody struct {
 	// verification email, a verification link will be sent. If the email is not known,
 	// a email with details on what happened will be sent instead.
 	//
+	// Can be omitted in favor of `phone` if `courier.sms.enabled` is true.
+	//
 	// format: email
 	// required: true
 	Email string `json:"email"`
 
+	// Phone Number to Verify
+	//
+	// Can be set instead of `email` when initiating the flow if `courier.sms.enabled` is true. If the phone
+	// number is a registered verification address, an SMS containing a code will be sent. No SMS is sent
+	// to unknown phone numbers.
+	Phone string `json:"phone"`
+
+	// Code sent via SMS
+	//
+	// Completes the flow if it is the code which was sent to the phone number.
+	Code string `json:"code"`
+
 	// Sending the anti-csrf token is only required for browser login flows.
 	CSRFToken string `form:"csrf_token" json:"csrf_token"`
 
@@ -120,6 +225,10 @@ func (s *Strategy) Verify(w http.ResponseWriter, r *http.Request, f *verificatio
 		return s.verificationUseToken(w, r, body)
 	}
 
+	if len(body.Code) > 0 {
+		return s.verificationUseCode(w, r, f, body)
+	}
+
 	if err := flow.MethodEnabledAndAllowed(r.Context(), s.VerificationStrategyID(), body.Method, s.d); err != nil {
 		return s.handleVerificationError(w, r, f, body, err)
 	}
@@ -148,6 +257,10 @@ func (s *Strategy) verificationHandleFormSubmission(w http.ResponseWriter, r *ht
 		return s.handleVerificationError(w, r, f, body, err)
 	}
 
+	if len(body.Phone) > 0 && s.d.Config(r.Context()).CourierSMSEnabled() {
+		return s.verificationHandlePhoneSubmission(w, r, f, body)
+	}
+
 	if len(body.Email) == 0 {
 		return s.handleVerificationError(w, r, f, body, schema.NewRequiredError("#/email", "email"))
 	}
@@ -179,6 +292,71 @@ func (s *Strategy) verificationHandleFormSubmission(w http.ResponseWriter, r *ht
 	return nil
 }
 
+func (s *Strategy) verificationHandlePhoneSubmission(w http.ResponseWriter, r *http.Request, f *verification.Flow, body *verificationSubmitPayload) error {
+	phone, err := identity.NormalizePhoneNumber(body.Phone)
+	if err != nil {
+		return s.handleVerificationError(w, r, f, body, schema.NewInvalidFormatError("#/phone", "tel", body.Phone))
+	}
+
+	if err := flow.EnsureCSRF(s.d, r, f.Type, s.d.Config(r.Context()).DisableAPIFlowEnforcement(), s.d.GenerateCSRFToken, body.CSRFToken); err != nil {
+		return s.handleVerificationError(w, r, f, body, err)
+	}
+
+	if err := s.d.LinkSender().SendVerificationLink(r.Context(), f, identity.VerifiableAddressTypeSMS, phone); err != nil {
+		if !errors.Is(err, ErrUnknownAddress) {
+			return s.handleVerificationError(w, r, f, body, err)
+		}
+		// Continue execution
+	}
+
+	f.UI.SetCSRF(s.d.GenerateCSRFToken(r))
+	s.upsertAddressNodes(r, f.UI.GetNodes(), node.VerificationLinkGroup, "", phone)
+	upsertCodeNode(f.UI.GetNodes(), node.VerificationLinkGroup)
+
+	f.Active = sqlxx.NullString(s.VerificationNodeGroup())
+	f.State = verification.StateEmailSent
+	f.UI.Messages.Set(text.NewVerificationSMSSent())
+	if err := s.d.VerificationFlowPersister().UpdateVerificationFlow(r.Context(), f); err != nil {
+		return s.handleVerificationError(w, r, f, body, err)
+	}
+
+	return nil
+}
+
+func (s *Strategy) verificationUseCode(w http.ResponseWriter, r *http.Request, f *verification.Flow, body *verificationSubmitPayload) error {
+	if err := flow.MethodEnabledAndAllowed(r.Context(), s.VerificationStrategyID(), body.Method, s.d); err != nil {
+		return s.handleVerificationError(w, r, f, body, err)
+	}
+
+	if err := f.Valid(); err != nil {
+		return s.handleVerificationError(w, r, f, body, err)
+	}
+
+	if err := flow.EnsureCSRF(s.d, r, f.Type, s.d.Config(r.Context()).DisableAPIFlowEnforcement(), s.d.GenerateCSRFToken, body.CSRFToken); err != nil {
+		return s.handleVerificationError(w, r, f, body, err)
+	}
+
+	token, err := s.d.VerificationTokenPersister().UseVerificationCode(r.Context(), f.ID, body.Code)
+	if err != nil {
+		if errors.Is(err, sqlcon.ErrNoRows) {
+			return s.handleVerificationError(w, r, f, body, schema.NewCodeInvalidError("#/code"))
+		}
+
+		return s.handleVerificationError(w, r, f, body, err)
+	}
+
+	if err := s.verificationVerifyAddress(w, r, f, token); err != nil {
+		return err
+	}
+
+	// API and SPA flows receive the updated flow from the handler instead of being redirected.
+	if f.Type != flow.TypeBrowser || x.IsJSONRequest(r) {
+		return nil
+	}
+
+	return s.verificationRedirect(w, r, f)
+}
+
 // nolint:deadcode,unused
 // swagger:parameters selfServiceBrowserVerify
 type selfServiceBrowserVerifyParameters struct {
@@ -214,6 +392,15 @@ func (s *Strategy) verificationUseToken(w http.ResponseWriter, r *http.Request,
 		}
 	}
 
+	if err := s.verificationVerifyAddress(w, r, f, token); err != nil {
+		return err
+	}
+
+	return s.verificationRedirect(w, r, f)
+}
+
+// verificationVerifyAddress completes the flow and marks the token's address as verified.
+func (s *Strategy) verificationVerifyAddress(w http.ResponseWriter, r *http.Request, f *verification.Flow, token *VerificationToken) error {
 	if err := token.Valid(); err != nil {
 		return s.retryVerificationFlowWithError(w, r, flow.TypeBrowser, err)
 	}
@@ -245,6 +432,11 @@ func (s *Strategy) verificationUseToken(w http.ResponseWriter, r *http.Request,
 		return s.retryVerificationFlowWithError(w, r, flow.TypeBrowser, err)
 	}
 
+	return nil
+}
+
+// verificationRedirect redirects to the verification UI or the flow's return_to URL.
+func (s *Strategy) verificationRedirect(w http.ResponseWriter, r *http.Request, f *verification.Flow) error {
 	defaultRedirectURL := s.d.Config(r.Context()).SelfServiceFlowVerificationReturnTo(f.AppendTo(s.d.Config(r.Context()).SelfServiceFlowVerificationUI()))
 
 	verificationRequestURL, err := urlx.Parse(f.GetRequestURL())
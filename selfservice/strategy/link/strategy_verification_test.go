@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
+	"github.com/ory/kratos/courier"
 	"github.com/ory/kratos/driver/config"
 	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/internal"
@@ -384,3 +385,180 @@ func TestVerification(t *testing.T) {
 
 	})
 }
+
+func TestVerificationWithSMS(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	initViper(t, conf)
+	conf.MustSet(config.ViperKeyDefaultIdentitySchemaURL, "file://./stub/phone.schema.json")
+	conf.MustSet(config.ViperKeyCourierSMSEnabled, true)
+	conf.MustSet(config.ViperKeyCourierSMSRequestConfig, map[string]interface{}{"url": "https://sms.example.org/send"})
+
+	_ = testhelpers.NewVerificationUIFlowEchoServer(t, reg)
+	_ = testhelpers.NewErrorTestServer(t, reg)
+
+	public, _ := testhelpers.NewKratosServerWithCSRF(t, reg)
+
+	phone := "+4917612345678"
+	id := &identity.Identity{Traits: identity.Traits(`{"email":"verify-sms@ory.sh","phone":"+49 176 12345678"}`)}
+	require.NoError(t, reg.IdentityManager().Create(context.Background(), id, identity.ManagerAllowWriteProtectedTraits))
+
+	var newClient = func(t *testing.T, isAPI bool) *http.Client {
+		if isAPI {
+			return testhelpers.NewDebugClient(t)
+		}
+		return testhelpers.NewClientWithCookies(t)
+	}
+
+	var requestCode = func(t *testing.T, hc *http.Client, isAPI, isSPA bool, phone string, c int) string {
+		return testhelpers.SubmitVerificationForm(t, isAPI, isSPA, hc, public, func(v url.Values) {
+			v.Del("email")
+			v.Set("phone", phone)
+		}, c, testhelpers.ExpectURL(isAPI || isSPA, public.URL+verification.RouteSubmitFlow, conf.SelfServiceFlowVerificationUI().String()))
+	}
+
+	var submitCode = func(t *testing.T, hc *http.Client, isAPI bool, flow, code string) (string, *http.Response) {
+		values := url.Values{
+			"method":     {"link"},
+			"code":       {code},
+			"csrf_token": {gjson.Get(flow, "ui.nodes.#(attributes.name==csrf_token).attributes.value").String()},
+		}
+		res, err := hc.Do(testhelpers.NewRequest(t, isAPI, "POST", gjson.Get(flow, "ui.action").String(),
+			bytes.NewBufferString(testhelpers.EncodeFormAsJSON(t, isAPI, values))))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		return string(ioutilx.MustReadAll(res.Body)), res
+	}
+
+	t.Run("description=should show the phone number field", func(t *testing.T) {
+		rs := testhelpers.GetVerificationFlow(t, testhelpers.NewClientWithCookies(t), public)
+		var found bool
+		for _, n := range rs.Ui.Nodes {
+			if attr := n.Attributes.UiNodeInputAttributes; attr != nil && attr.Name == "phone" {
+				found = true
+				assert.EqualValues(t, node.InputAttributeTypeTel, attr.Type)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("description=should reject an invalid phone number", func(t *testing.T) {
+		body := requestCode(t, newClient(t, false), false, false, "12345", http.StatusOK)
+		assert.NotEmpty(t, gjson.Get(body, "ui.nodes.#(attributes.name==phone).messages.0.text").String(), "%s", body)
+	})
+
+	t.Run("description=should not send an SMS to an unknown phone number", func(t *testing.T) {
+		body := requestCode(t, newClient(t, false), false, false, "+4917600000000", http.StatusOK)
+		assertx.EqualAsJSON(t, text.NewVerificationSMSSent(), json.RawMessage(gjson.Get(body, "ui.messages.0").Raw))
+
+		message, err := reg.CourierPersister().LatestQueuedMessage(context.Background())
+		if err == nil {
+			assert.NotEqual(t, "+4917600000000", message.Recipient)
+		}
+	})
+
+	t.Run("description=should verify a phone number using the code", func(t *testing.T) {
+		for _, tc := range []struct {
+			d            string
+			isAPI, isSPA bool
+		}{
+			{d: "browser"},
+			{d: "spa", isSPA: true},
+			{d: "api", isAPI: true},
+		} {
+			t.Run("type="+tc.d, func(t *testing.T) {
+				hc := newClient(t, tc.isAPI)
+				body := requestCode(t, hc, tc.isAPI, tc.isSPA, "0049 176 123 456 78", http.StatusOK)
+				assert.EqualValues(t, phone, gjson.Get(body, "ui.nodes.#(attributes.name==phone).attributes.value").String(), "%s", body)
+				assert.True(t, gjson.Get(body, "ui.nodes.#(attributes.name==code)").Exists(), "%s", body)
+				assertx.EqualAsJSON(t, text.NewVerificationSMSSent(), json.RawMessage(gjson.Get(body, "ui.messages.0").Raw))
+
+				message, err := reg.CourierPersister().LatestQueuedMessage(context.Background())
+				require.NoError(t, err)
+				assert.Equal(t, courier.MessageTypeSMS, message.Type)
+				assert.Equal(t, phone, message.Recipient)
+				code := testhelpers.CourierExpectCodeInMessage(t, message)
+
+				isJSON := tc.isAPI || tc.isSPA
+				actual, res := submitCode(t, hc, isJSON, body, "not-"+code)
+				assert.Equal(t, testhelpers.ExpectStatusCode(isJSON, http.StatusBadRequest, http.StatusOK), res.StatusCode, "%s", actual)
+				assert.Equal(t, text.NewErrorValidationCodeInvalid().Text, gjson.Get(actual, "ui.nodes.#(attributes.name==code).messages.0.text").String(), "%s", actual)
+
+				actual, res = submitCode(t, hc, isJSON, actual, code)
+				assert.Equal(t, http.StatusOK, res.StatusCode, "%s", actual)
+				assert.Contains(t, res.Request.URL.String(), testhelpers.ExpectURL(isJSON, public.URL+verification.RouteSubmitFlow, conf.SelfServiceFlowVerificationUI().String()))
+				assert.EqualValues(t, verification.StatePassedChallenge, gjson.Get(actual, "state").String(), "%s", actual)
+
+				address, err := reg.IdentityPool().FindVerifiableAddressByValue(context.Background(), identity.VerifiableAddressTypeSMS, phone)
+				require.NoError(t, err)
+				assert.True(t, address.Verified)
+				assert.EqualValues(t, identity.VerifiableAddressStatusCompleted, address.Status)
+
+				_, res = submitCode(t, hc, isJSON, body, code)
+				assert.Equal(t, testhelpers.ExpectStatusCode(isJSON, http.StatusBadRequest, http.StatusOK), res.StatusCode, "codes can only be used once")
+			})
+		}
+	})
+}
+
+func TestAdminSendVerificationLink(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	initViper(t, conf)
+
+	_ = testhelpers.NewVerificationUIFlowEchoServer(t, reg)
+	_ = testhelpers.NewErrorTestServer(t, reg)
+
+	publicTS, adminTS := testhelpers.NewKratosServer(t, reg)
+	adminSDK := testhelpers.NewSDKClient(adminTS)
+
+	email := "admin-verify@ory.sh"
+	id := identity.Identity{Traits: identity.Traits(`{"email":"` + email + `"}`)}
+	require.NoError(t, reg.IdentityManager().Create(context.Background(), &id, identity.ManagerAllowWriteProtectedTraits))
+	require.Len(t, id.VerifiableAddresses, 1)
+	addressID := id.VerifiableAddresses[0].ID.String()
+
+	t.Run("description=should send a verification link which verifies the address", func(t *testing.T) {
+		_, err := adminSDK.V0alpha2Api.AdminSendSelfServiceVerificationLink(context.Background(), addressID).Execute()
+		require.NoError(t, err)
+
+		address, err := reg.PrivilegedIdentityPool().GetVerifiableAddress(context.Background(), id.VerifiableAddresses[0].ID)
+		require.NoError(t, err)
+		assert.EqualValues(t, identity.VerifiableAddressStatusSent, address.Status)
+
+		message := testhelpers.CourierExpectMessage(t, reg, email, "Please verify your email address")
+		verificationLink := testhelpers.CourierExpectLinkInMessage(t, message, 1)
+		assert.Contains(t, verificationLink, publicTS.URL+verification.RouteSubmitFlow)
+
+		res, err := testhelpers.NewClientWithCookies(t).Get(verificationLink)
+		require.NoError(t, err)
+		body := string(ioutilx.MustReadAll(res.Body))
+		require.NoError(t, res.Body.Close())
+		assert.EqualValues(t, "passed_challenge", gjson.Get(body, "state").String(), "%s", body)
+
+		address, err = reg.PrivilegedIdentityPool().GetVerifiableAddress(context.Background(), id.VerifiableAddresses[0].ID)
+		require.NoError(t, err)
+		assert.True(t, address.Verified)
+	})
+
+	t.Run("description=should not send a verification link to a verified address", func(t *testing.T) {
+		res, err := adminSDK.V0alpha2Api.AdminSendSelfServiceVerificationLink(context.Background(), addressID).Execute()
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("description=should fail for an unknown address", func(t *testing.T) {
+		res, err := adminSDK.V0alpha2Api.AdminSendSelfServiceVerificationLink(context.Background(), x.NewUUID().String()).Execute()
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("description=should fail if verification is disabled", func(t *testing.T) {
+		conf.MustSet(config.ViperKeySelfServiceVerificationEnabled, false)
+		t.Cleanup(func() {
+			conf.MustSet(config.ViperKeySelfServiceVerificationEnabled, true)
+		})
+
+		res, err := adminSDK.V0alpha2Api.AdminSendSelfServiceVerificationLink(context.Background(), addressID).Execute()
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
@@ -91,6 +91,38 @@ func TestPersister(ctx context.Context, conf *config.Config, p interface {
 				require.Error(t, err)
 			})
 
+			t.Run("case=should use a recovery code within its flow only", func(t *testing.T) {
+				expected := newRecoveryToken(t, "code-user@ory.sh")
+				expected.Code = "123456"
+				require.NoError(t, p.CreateRecoveryToken(ctx, expected))
+				assert.EqualValues(t, "123456", expected.Code)
+
+				_, err := p.UseRecoveryCode(ctx, x.NewUUID(), "123456")
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+				actual, err := p.UseRecoveryCode(ctx, expected.FlowID.UUID, "123456")
+				require.NoError(t, err)
+				assert.Equal(t, expected.ID, actual.ID)
+				assert.Equal(t, expected.IdentityID, actual.IdentityID)
+
+				_, err = p.UseRecoveryCode(ctx, expected.FlowID.UUID, "123456")
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
+			t.Run("case=should invalidate recovery codes after too many attempts", func(t *testing.T) {
+				expected := newRecoveryToken(t, "code-attempts-user@ory.sh")
+				expected.Code = "123456"
+				require.NoError(t, p.CreateRecoveryToken(ctx, expected))
+
+				for i := 0; i < link.CodeMaxAttempts; i++ {
+					_, err := p.UseRecoveryCode(ctx, expected.FlowID.UUID, "654321")
+					require.ErrorIs(t, err, sqlcon.ErrNoRows)
+				}
+
+				_, err := p.UseRecoveryCode(ctx, expected.FlowID.UUID, "123456")
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
 		})
 
 		t.Run("token=verification", func(t *testing.T) {
@@ -151,6 +183,44 @@ func TestPersister(ctx context.Context, conf *config.Config, p interface {
 				_, err = p.UseVerificationToken(ctx, expected.Token)
 				require.Error(t, err)
 			})
+
+			t.Run("case=should use a verification code within its flow only", func(t *testing.T) {
+				expected := newVerificationToken(t, "code-user@ory.sh")
+				expected.Code = "123456"
+				require.NoError(t, p.CreateVerificationToken(ctx, expected))
+				assert.EqualValues(t, "123456", expected.Code)
+
+				_, err := p.UseVerificationCode(ctx, x.NewUUID(), "123456")
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+				t.Run("not work on another network", func(t *testing.T) {
+					_, p := testhelpers.NewNetwork(t, ctx, p)
+					_, err := p.UseVerificationCode(ctx, expected.FlowID.UUID, "123456")
+					require.ErrorIs(t, err, sqlcon.ErrNoRows)
+				})
+
+				actual, err := p.UseVerificationCode(ctx, expected.FlowID.UUID, "123456")
+				require.NoError(t, err)
+				assert.Equal(t, expected.ID, actual.ID)
+				assert.Equal(t, expected.VerifiableAddress.ID, actual.VerifiableAddress.ID)
+
+				_, err = p.UseVerificationCode(ctx, expected.FlowID.UUID, "123456")
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
+			t.Run("case=should invalidate verification codes after too many attempts", func(t *testing.T) {
+				expected := newVerificationToken(t, "code-attempts-user@ory.sh")
+				expected.Code = "123456"
+				require.NoError(t, p.CreateVerificationToken(ctx, expected))
+
+				for i := 0; i < link.CodeMaxAttempts; i++ {
+					_, err := p.UseVerificationCode(ctx, expected.FlowID.UUID, "654321")
+					require.ErrorIs(t, err, sqlcon.ErrNoRows)
+				}
+
+				_, err := p.UseVerificationCode(ctx, expected.FlowID.UUID, "123456")
+				require.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
 		})
 	}
 }
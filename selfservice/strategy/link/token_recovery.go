@@ -12,6 +12,7 @@ import (
 	errors "github.com/pkg/errors"
 
 	"github.com/ory/x/randx"
+	"github.com/ory/x/sqlxx"
 
 	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/selfservice/flow/recovery"
@@ -29,6 +30,13 @@ type RecoveryToken struct {
 	// Token represents the recovery token. It can not be longer than 64 chars!
 	Token string `json:"-" db:"token"`
 
+	// Code is the one-time code which is sent instead of a link to phone numbers. Unlike the token, it is only
+	// valid within the flow it was issued for.
+	Code sqlxx.NullString `json:"-" db:"code"`
+
+	// CodeAttempts counts the wrong codes which were submitted for the flow of this token.
+	CodeAttempts int `json:"-" faker:"-" db:"code_attempts"`
+
 	// RecoveryAddress links this token to a recovery address.
 	// required: true
 	RecoveryAddress *identity.RecoveryAddress `json:"recovery_address" belongs_to:"identity_recovery_addresses" fk_id:"RecoveryAddressID"`
@@ -65,7 +73,7 @@ func NewSelfServiceRecoveryToken(address *identity.RecoveryAddress, f *recovery.
 		identityID = address.IdentityID
 		recoveryAddressID = address.ID
 	}
-	return &RecoveryToken{
+	token := &RecoveryToken{
 		ID:                x.NewUUID(),
 		Token:             randx.MustString(32, randx.AlphaNum),
 		RecoveryAddress:   address,
@@ -75,6 +83,10 @@ func NewSelfServiceRecoveryToken(address *identity.RecoveryAddress, f *recovery.
 		FlowID:            uuid.NullUUID{UUID: f.ID, Valid: true},
 		RecoveryAddressID: &recoveryAddressID,
 	}
+	if address != nil && address.Via == identity.RecoveryAddressTypeSMS {
+		token.Code = newCode()
+	}
+	return token
 }
 
 func NewRecoveryToken(identityID uuid.UUID, expiresIn time.Duration) *RecoveryToken {
@@ -14,8 +14,10 @@ import (
 	"github.com/ory/x/stringslice"
 	"github.com/ory/x/urlx"
 
+	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/selfservice/flow"
 	"github.com/ory/kratos/selfservice/flow/recovery"
+	"github.com/ory/kratos/x"
 )
 
 func TestRecoveryToken(t *testing.T) {
@@ -34,6 +36,17 @@ func TestRecoveryToken(t *testing.T) {
 
 			assert.Len(t, stringslice.Unique(tokens), len(tokens))
 		})
+
+		t.Run("case=creates a code for phone numbers only", func(t *testing.T) {
+			f, err := recovery.NewFlow(conf, time.Hour, "", req, nil, flow.TypeBrowser)
+			require.NoError(t, err)
+
+			token := link.NewSelfServiceRecoveryToken(identity.NewRecoveryEmailAddress("foo@ory.sh", x.NewUUID()), f, time.Hour)
+			assert.Empty(t, token.Code)
+
+			token = link.NewSelfServiceRecoveryToken(identity.NewRecoverySMSAddress("+4917612345678", x.NewUUID()), f, time.Hour)
+			assert.Regexp(t, "^[0-9]{6}$", token.Code)
+		})
 	})
 	t.Run("method=Valid", func(t *testing.T) {
 		t.Run("case=is invalid when the flow is expired", func(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/x/randx"
+	"github.com/ory/x/sqlxx"
 
 	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/selfservice/flow/verification"
@@ -29,6 +30,13 @@ type VerificationToken struct {
 	// Token represents the verification token. It can not be longer than 64 chars!
 	Token string `json:"-" db:"token"`
 
+	// Code is the one-time code which is sent instead of a link to phone numbers. Unlike the token, it is only
+	// valid within the flow it was issued for.
+	Code sqlxx.NullString `json:"-" db:"code"`
+
+	// CodeAttempts counts the wrong codes which were submitted for the flow of this token.
+	CodeAttempts int `json:"-" faker:"-" db:"code_attempts"`
+
 	// VerifiableAddress links this token to a verification address.
 	// required: true
 	VerifiableAddress *identity.VerifiableAddress `json:"verification_address" belongs_to:"identity_verifiable_addresses" fk_id:"VerificationAddVerifiableAddressIDressID"`
@@ -58,13 +66,17 @@ func (VerificationToken) TableName(ctx context.Context) string {
 
 func NewSelfServiceVerificationToken(address *identity.VerifiableAddress, f *verification.Flow, expiresIn time.Duration) *VerificationToken {
 	now := time.Now().UTC()
-	return &VerificationToken{
+	token := &VerificationToken{
 		ID:                x.NewUUID(),
 		Token:             randx.MustString(32, randx.AlphaNum),
 		VerifiableAddress: address,
 		ExpiresAt:         now.Add(expiresIn),
 		IssuedAt:          now,
 		FlowID:            uuid.NullUUID{UUID: f.ID, Valid: true}}
+	if address != nil && address.Via == identity.VerifiableAddressTypeSMS {
+		token.Code = newCode()
+	}
+	return token
 }
 
 func (f *VerificationToken) Valid() error {
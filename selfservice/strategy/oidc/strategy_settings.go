@@ -471,7 +471,7 @@ func (s *Strategy) unlinkProvider(w http.ResponseWriter, r *http.Request, ctxUpd
 	}
 
 	creds.Identifiers = updatedIdentifiers
-	creds.Config, err = json.Marshal(&CredentialsConfig{updatedProviders})
+	creds.Config, err = json.Marshal(&CredentialsConfig{Providers: updatedProviders})
 	if err != nil {
 		return s.handleSettingsError(w, r, ctxUpdate, p, errors.WithStack(err))
 
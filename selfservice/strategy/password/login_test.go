@@ -456,6 +456,36 @@ func TestCompleteLogin(t *testing.T) {
 		})
 	})
 
+	t.Run("should return an error because the identity is disabled", func(t *testing.T) {
+		identifier, pwd := x.NewUUID().String(), "password"
+		createIdentity(identifier, pwd)
+
+		found, _, err := reg.PrivilegedIdentityPool().FindByCredentialsIdentifier(context.Background(), identity.CredentialsTypePassword, identifier)
+		require.NoError(t, err)
+		i, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), found.ID)
+		require.NoError(t, err)
+		i.Deactivate("", nil)
+		require.NoError(t, reg.PrivilegedIdentityPool().UpdateIdentity(context.Background(), i))
+
+		var values = func(v url.Values) {
+			v.Set("password_identifier", identifier)
+			v.Set("password", pwd)
+		}
+
+		var check = func(t *testing.T, body string) {
+			assert.NotEmpty(t, gjson.Get(body, "id").String(), "%s", body)
+			assert.EqualValues(t, text.ErrorValidationIdentityDisabled, gjson.Get(body, "ui.messages.0.id").Int(), "%s", body)
+		}
+
+		t.Run("type=browser", func(t *testing.T) {
+			check(t, expectValidationError(t, false, false, false, values))
+		})
+
+		t.Run("type=api", func(t *testing.T) {
+			check(t, expectValidationError(t, true, false, false, values))
+		})
+	})
+
 	t.Run("should pass with real request", func(t *testing.T) {
 		identifier, pwd := x.NewUUID().String(), "password"
 		createIdentity(identifier, pwd)
@@ -2,16 +2,23 @@ package session
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
 	"github.com/ory/x/decoderx"
+	"github.com/ory/x/jsonx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/urlx"
 
 	"github.com/ory/herodot"
 
 	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/x"
 )
 
@@ -19,6 +26,7 @@ type (
 	handlerDependencies interface {
 		ManagementProvider
 		PersistenceProvider
+		identity.PrivilegedPoolProvider
 		x.WriterProvider
 		x.LoggingProvider
 		x.CSRFProvider
@@ -43,33 +51,59 @@ func NewHandler(
 }
 
 const (
-	RouteCollection    = "/sessions"
-	RouteWhoami        = RouteCollection + "/whoami"
-	RouteIdentity      = "/identities"
-	RouteDeleteSession = RouteIdentity + "/:id/sessions"
+	RouteCollection       = "/sessions"
+	RouteWhoami           = RouteCollection + "/whoami"
+	RouteRefresh          = RouteCollection + "/refresh"
+	RouteSession          = RouteCollection + "/:id"
+	RouteIdentity         = "/identities"
+	RouteIdentitySessions = RouteIdentity + "/:id/sessions"
+	RouteDeleteSession    = RouteIdentitySessions
+	RouteDeactivate       = RouteIdentity + "/:id/deactivate"
+	RouteCredentials      = RouteIdentity + "/:id/credentials/:type"
 )
 
 func (h *Handler) RegisterAdminRoutes(admin *x.RouterAdmin) {
-	for _, m := range []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
-		http.MethodDelete} {
+	// DELETE is not part of this list because `DELETE /sessions/whoami` collides with `DELETE /sessions/:id`.
+	// The redirect for that method is handled by adminDeleteSession.
+	for _, m := range []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch} {
 		// Redirect to public endpoint
 		admin.Handle(m, RouteWhoami, x.RedirectToPublicRoute(h.r))
 	}
+	admin.PATCH(RouteRefresh, x.RedirectToPublicRoute(h.r))
 
+	admin.DELETE(RouteSession, h.adminDeleteSession)
+	admin.GET(RouteIdentitySessions, h.adminListIdentitySessions)
 	admin.DELETE(RouteDeleteSession, h.deleteIdentitySessions)
+	admin.POST(RouteDeactivate, identity.AttributeChangesTo(identity.HistoryCauseAdminAPI, h.deactivateIdentity))
+	admin.DELETE(RouteCredentials, identity.AttributeChangesTo(identity.HistoryCauseAdminAPI, h.deleteIdentityCredentials))
 }
 
 func (h *Handler) RegisterPublicRoutes(public *x.RouterPublic) {
 	// We need to completely ignore the whoami/logout path so that we do not accidentally set
 	// some cookie.
 	h.r.CSRFHandler().IgnorePath(RouteWhoami)
+	h.r.CSRFHandler().IgnorePath(RouteCollection)
+	h.r.CSRFHandler().IgnoreGlob(RouteCollection + "/*")
 	h.r.CSRFHandler().IgnoreGlob(RouteIdentity + "/*/sessions")
+	h.r.CSRFHandler().IgnoreGlob(RouteIdentity + "/*/deactivate")
+	h.r.CSRFHandler().IgnoreGlob(RouteIdentity + "/*/credentials/*")
 
+	// DELETE is not part of this list because `DELETE /sessions/whoami` collides with `DELETE /sessions/:id`.
+	// That method is forwarded to whoami by revokeSession.
 	for _, m := range []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
-		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace} {
+		http.MethodConnect, http.MethodOptions, http.MethodTrace} {
 		public.Handle(m, RouteWhoami, h.whoami)
 	}
+
+	public.PATCH(RouteRefresh, h.refresh)
+	public.GET(RouteCollection, h.listSessions)
+	public.DELETE(RouteCollection, h.revokeSessions)
+	public.DELETE(RouteSession, h.revokeSession)
+
+	public.GET(RouteIdentitySessions, x.RedirectToAdminRoute(h.r))
 	public.DELETE(RouteDeleteSession, x.RedirectToAdminRoute(h.r))
+	public.POST(RouteDeactivate, x.RedirectToAdminRoute(h.r))
+	public.DELETE(RouteCredentials, x.RedirectToAdminRoute(h.r))
 }
 
 // nolint:deadcode,unused
@@ -119,6 +153,10 @@ type toSession struct {
 //  // console.log(session)
 //	```
 //
+// If `session.idle_timeout` is configured, calling this endpoint counts as activity and extends the session's
+// `expires_at` by the idle timeout, but never beyond `session.lifespan`. To limit the number of writes, the session is
+// only extended once it expires within `session.earliest_possible_extend`.
+//
 // Depending on your configuration this endpoint might return a 403 status code if the session has a lower Authenticator
 // Assurance Level (AAL) than is possible for the identity. This can happen if the identity has password + webauthn
 // credentials (which would result in AAL2) but the session has only AAL1. If this error occurs, ask the user
@@ -154,25 +192,22 @@ type toSession struct {
 //       403: jsonError
 //       500: jsonError
 func (h *Handler) whoami(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	s, err := h.r.SessionManager().FetchFromRequest(r.Context(), r)
+	s, err := h.fetchFromRequest(r)
 	if err != nil {
-		h.r.Audit().WithRequest(r).WithError(err).Info("No valid session cookie found.")
-		h.r.Writer().WriteError(w, r, herodot.ErrUnauthorized.WithWrap(err).WithReasonf("No valid session cookie found."))
+		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
-	var aalErr *ErrAALNotSatisfied
-	if err := h.r.SessionManager().DoesSessionSatisfy(r, s, h.r.Config(r.Context()).SessionWhoAmIAAL()); errors.As(err, &aalErr) {
-		h.r.Audit().WithRequest(r).WithError(err).Info("Session was found but AAL is not satisfied for calling this endpoint.")
+	if err := h.r.SessionManager().ExtendSessionOnActivity(r.Context(), s); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
-	} else if err != nil {
-		h.r.Audit().WithRequest(r).WithError(err).Info("No valid session cookie found.")
-		h.r.Writer().WriteError(w, r, herodot.ErrUnauthorized.WithWrap(err).WithReasonf("Unable to determine AAL."))
+	}
+
+	if err := h.r.SessionManager().RecordDeviceActivity(r.Context(), r, s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
-	// s.Devices = nil
 	s.Identity = s.Identity.CopyWithoutCredentials()
 
 	// Set userId as the X-Kratos-Authenticated-Identity-Id header.
@@ -181,6 +216,338 @@ func (h *Handler) whoami(w http.ResponseWriter, r *http.Request, ps httprouter.P
 	h.r.Writer().Write(w, r, s)
 }
 
+// swagger:parameters refreshSession
+// nolint:deadcode,unused
+type refreshSession struct {
+	// Set the Session Token when calling from non-browser clients. A session token has a format of `MP2YWEMeM8MxjkGKpH4dqOQ4Q4DlSPaj`.
+	//
+	// in: header
+	SessionToken string `json:"X-Session-Token"`
+
+	// Set the Cookie Header. This is especially useful when calling this endpoint from a server-side application. In that
+	// scenario you must include the HTTP Cookie Header which originally was included in the request to your server.
+	//
+	// in: header
+	Cookie string `json:"Cookie"`
+}
+
+// swagger:route PATCH /sessions/refresh v0alpha2 refreshSession
+//
+// Refresh the Current Session
+//
+// Calling this endpoint extends the `expires_at` of the session used to call it by `session.idle_timeout`, but never
+// beyond `session.lifespan`. Unlike `/sessions/whoami`, the session is always extended, no matter when it expires.
+// If `session.idle_timeout` is not configured, sessions have a fixed lifespan and are returned unchanged.
+//
+// This endpoint is useful for:
+//
+// - Native and other API clients which keep the user signed in while the app is in use
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Responses:
+//       200: session
+//       401: jsonError
+//       403: jsonError
+//       500: jsonError
+func (h *Handler) refresh(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	s, err := h.fetchFromRequest(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.SessionManager().ExtendSession(r.Context(), s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, s.Declassify())
+}
+
+// fetchFromRequest returns the session of the request if it satisfies the AAL required for whoami.
+func (h *Handler) fetchFromRequest(r *http.Request) (*Session, error) {
+	s, err := h.r.SessionManager().FetchFromRequest(r.Context(), r)
+	if err != nil {
+		h.r.Audit().WithRequest(r).WithError(err).Info("No valid session cookie found.")
+		return nil, herodot.ErrUnauthorized.WithWrap(err).WithReasonf("No valid session cookie found.")
+	}
+
+	var aalErr *ErrAALNotSatisfied
+	if err := h.r.SessionManager().DoesSessionSatisfy(r, s, h.r.Config(r.Context()).SessionWhoAmIAAL()); errors.As(err, &aalErr) {
+		h.r.Audit().WithRequest(r).WithError(err).Info("Session was found but AAL is not satisfied for calling this endpoint.")
+		return nil, err
+	} else if err != nil {
+		h.r.Audit().WithRequest(r).WithError(err).Info("No valid session cookie found.")
+		return nil, herodot.ErrUnauthorized.WithWrap(err).WithReasonf("Unable to determine AAL.")
+	}
+
+	return s, nil
+}
+
+// parsePage parses the keyset pagination parameters. The deprecated offset pagination is not supported by the
+// session lists and falls back to the first page.
+func parsePage(r *http.Request) (x.Page, error) {
+	page, err := x.ParsePage(r)
+	if err != nil {
+		return page, err
+	}
+
+	if page.UseOffset {
+		return x.KeysetPage(page.ItemsPerPage), nil
+	}
+	return page, nil
+}
+
+func declassify(ss []*Session) []*Session {
+	for k := range ss {
+		ss[k].Declassify()
+	}
+	return ss
+}
+
+func writePaginationHeader(w http.ResponseWriter, r *http.Request, u *url.URL, page x.Page, ss []*Session) {
+	u.RawQuery = r.URL.RawQuery
+
+	var last uuid.UUID
+	if len(ss) > 0 {
+		last = ss[len(ss)-1].ID
+	}
+	x.KeysetPaginationHeader(w, u, page, len(ss), last)
+}
+
+// A list of sessions.
+// swagger:model sessionList
+// nolint:deadcode,unused
+type sessionList []*Session
+
+// swagger:parameters listSessions
+// nolint:deadcode,unused
+type listSessions struct {
+	// Set the Session Token when calling from non-browser clients. A session token has a format of `MP2YWEMeM8MxjkGKpH4dqOQ4Q4DlSPaj`.
+	//
+	// in: header
+	SessionToken string `json:"X-Session-Token"`
+
+	// Set the Cookie Header. This is especially useful when calling this endpoint from a server-side application. In that
+	// scenario you must include the HTTP Cookie Header which originally was included in the request to your server.
+	//
+	// in: header
+	Cookie string `json:"Cookie"`
+
+	// Items per Page
+	//
+	// This is the number of items per page.
+	//
+	// required: false
+	// in: query
+	// default: 250
+	// min: 1
+	// max: 1000
+	PerPage int `json:"per_page"`
+
+	// Page Token
+	//
+	// The token of the page to return. Omit it to get the first page. The token of the next page is part
+	// of the `next` relation in the `Link` response header.
+	//
+	// required: false
+	// in: query
+	PageToken string `json:"page_token"`
+}
+
+// swagger:route GET /sessions v0alpha2 listSessions
+//
+// List the Active Sessions of the Current Identity
+//
+// Lists the active sessions of the identity the session used to call this endpoint belongs to, including the
+// session itself. Each session contains when it was issued and authenticated, its Authenticator Assurance Level,
+// and the authentication methods used.
+//
+// Sessions are ordered by descending ID and paginated using the `page_token` query parameter. The `Link` response
+// header contains the URL of the first page and, unless this is the last page, of the next page.
+//
+// This endpoint is useful for:
+//
+// - Displaying all other sessions that belong to the logged-in user
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Responses:
+//       200: sessionList
+//       400: jsonError
+//       401: jsonError
+//       403: jsonError
+//       500: jsonError
+func (h *Handler) listSessions(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	s, err := h.fetchFromRequest(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	page, err := parsePage(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	active := true
+	ss, err := h.r.SessionPersister().ListSessionsByIdentity(r.Context(), s.IdentityID, &active, page)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	writePaginationHeader(w, r, urlx.AppendPaths(h.r.Config(r.Context()).SelfPublicURL(r), RouteCollection), page, ss)
+	h.r.Writer().Write(w, r, declassify(ss))
+}
+
+// swagger:parameters revokeSessions
+// nolint:deadcode,unused
+type revokeSessions struct {
+	// Set the Session Token when calling from non-browser clients. A session token has a format of `MP2YWEMeM8MxjkGKpH4dqOQ4Q4DlSPaj`.
+	//
+	// in: header
+	SessionToken string `json:"X-Session-Token"`
+
+	// Set the Cookie Header. This is especially useful when calling this endpoint from a server-side application. In that
+	// scenario you must include the HTTP Cookie Header which originally was included in the request to your server.
+	//
+	// in: header
+	Cookie string `json:"Cookie"`
+}
+
+// The Response for Revoking Sessions
+//
+// swagger:model revokedSessions
+type RevokedSessions struct {
+	// The number of sessions that were revoked.
+	//
+	// required: true
+	Count int `json:"count"`
+}
+
+// swagger:route DELETE /sessions v0alpha2 revokeSessions
+//
+// Revoke All Other Sessions of the Current Identity
+//
+// Calling this endpoint revokes all active sessions of the identity the session used to call this endpoint
+// belongs to, except for the session itself. To sign out of the current session, use the logout flow instead.
+//
+// This endpoint is useful for:
+//
+// - Signing out of all other devices, for example after changing the password
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Responses:
+//       200: revokedSessions
+//       401: jsonError
+//       403: jsonError
+//       500: jsonError
+func (h *Handler) revokeSessions(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	s, err := h.fetchFromRequest(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	n, err := h.r.SessionPersister().RevokeSessionsIdentityExcept(r.Context(), s.IdentityID, s.ID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, &RevokedSessions{Count: n})
+}
+
+// swagger:parameters revokeSession
+// nolint:deadcode,unused
+type revokeSession struct {
+	// ID is the session's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// Set the Session Token when calling from non-browser clients. A session token has a format of `MP2YWEMeM8MxjkGKpH4dqOQ4Q4DlSPaj`.
+	//
+	// in: header
+	SessionToken string `json:"X-Session-Token"`
+
+	// Set the Cookie Header. This is especially useful when calling this endpoint from a server-side application. In that
+	// scenario you must include the HTTP Cookie Header which originally was included in the request to your server.
+	//
+	// in: header
+	Cookie string `json:"Cookie"`
+}
+
+// swagger:route DELETE /sessions/{id} v0alpha2 revokeSession
+//
+// Revoke a Session
+//
+// Calling this endpoint on the public API revokes the given session if it belongs to the identity the session used
+// to call this endpoint belongs to. The session used to call this endpoint can not be revoked here, use the logout
+// flow instead.
+//
+// Calling this endpoint on the admin API revokes any session. Revoked sessions can no longer be used but are kept
+// and listed as inactive.
+//
+// This endpoint is useful for:
+//
+// - Signing out of a lost or unknown device
+//
+//     Schemes: http, https
+//
+//     Responses:
+//       204: emptyResponse
+//       400: jsonError
+//       401: jsonError
+//       403: jsonError
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) revokeSession(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if ps.ByName("id") == "whoami" {
+		// `DELETE /sessions/whoami` collides with this route, see RegisterPublicRoutes.
+		h.whoami(w, r, ps)
+		return
+	}
+
+	sID, err := uuid.FromString(ps.ByName("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()).WithDebug("could not parse UUID"))
+		return
+	}
+
+	s, err := h.fetchFromRequest(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if s.ID == sID {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("The session used to call this endpoint can not be revoked here. Use the logout flow instead.")))
+		return
+	}
+
+	if err := h.r.SessionPersister().RevokeSession(r.Context(), s.IdentityID, sID); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // swagger:parameters adminDeleteIdentitySessions
 // nolint:deadcode,unused
 type adminDeleteIdentitySessions struct {
@@ -224,6 +591,301 @@ func (h *Handler) deleteIdentitySessions(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// swagger:parameters adminListIdentitySessions
+// nolint:deadcode,unused
+type adminListIdentitySessions struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// Active
+	//
+	// Only return active and unexpired sessions if true, or only inactive and expired sessions if false.
+	// Omit it to return all sessions.
+	//
+	// required: false
+	// in: query
+	Active bool `json:"active"`
+
+	// Items per Page
+	//
+	// This is the number of items per page.
+	//
+	// required: false
+	// in: query
+	// default: 250
+	// min: 1
+	// max: 1000
+	PerPage int `json:"per_page"`
+
+	// Page Token
+	//
+	// The token of the page to return. Omit it to get the first page. The token of the next page is part
+	// of the `next` relation in the `Link` response header.
+	//
+	// required: false
+	// in: query
+	PageToken string `json:"page_token"`
+}
+
+// swagger:route GET /identities/{id}/sessions v0alpha2 adminListIdentitySessions
+//
+// List the Sessions of an Identity
+//
+// Lists all sessions of the given identity. Use the `active` query parameter to only list active or inactive
+// sessions.
+//
+// Sessions are ordered by descending ID and paginated using the `page_token` query parameter. The `Link` response
+// header contains the URL of the first page and, unless this is the last page, of the next page.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: sessionList
+//       400: jsonError
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) adminListIdentitySessions(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	iID, err := uuid.FromString(ps.ByName("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()).WithDebug("could not parse UUID"))
+		return
+	}
+
+	var active *bool
+	if v := r.URL.Query().Get("active"); v != "" {
+		a, err := strconv.ParseBool(v)
+		if err != nil {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Parameter `active` must be a boolean: %s", err)))
+			return
+		}
+		active = &a
+	}
+
+	page, err := parsePage(r)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	ss, err := h.r.SessionPersister().ListSessionsByIdentity(r.Context(), iID, active, page)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	writePaginationHeader(w, r, urlx.AppendPaths(h.r.Config(r.Context()).SelfAdminURL(), RouteIdentity, iID.String(), "sessions"), page, ss)
+	h.r.Writer().Write(w, r, declassify(ss))
+}
+
+// adminDeleteSession is the admin API variant of revokeSession which revokes any session. Both share
+// `DELETE /sessions/{id}` and are documented together.
+func (h *Handler) adminDeleteSession(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	if ps.ByName("id") == "whoami" {
+		// `DELETE /sessions/whoami` collides with this route, see RegisterAdminRoutes.
+		x.RedirectToPublicRoute(h.r)(w, r, ps)
+		return
+	}
+
+	sID, err := uuid.FromString(ps.ByName("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()).WithDebug("could not parse UUID"))
+		return
+	}
+
+	s, err := h.r.SessionPersister().GetSession(r.Context(), sID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.SessionPersister().RevokeSession(r.Context(), s.IdentityID, s.ID); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:parameters adminDeactivateIdentity
+// nolint:deadcode,unused
+type adminDeactivateIdentity struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// in: body
+	Body AdminDeactivateIdentityBody
+}
+
+// swagger:model adminDeactivateIdentityBody
+type AdminDeactivateIdentityBody struct {
+	// Reason is an optional explanation of why the identity was deactivated.
+	Reason string `json:"reason"`
+
+	// ReactivateAt is an optional point in time after which the identity becomes active again.
+	ReactivateAt *time.Time `json:"reactivate_at"`
+}
+
+// swagger:route POST /identities/{id}/deactivate v0alpha2 adminDeactivateIdentity
+//
+// Deactivate an Identity
+//
+// Calling this endpoint sets the identity's state to `inactive` and revokes all of its sessions. Inactive identities
+// can not sign in, use their sessions, or recover their account.
+//
+// If `reactivate_at` is set, the identity becomes active again once that time has passed. To reactivate the
+// identity earlier, update its state to `active`.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       200: identity
+//       400: jsonError
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) deactivateIdentity(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	iID, err := uuid.FromString(ps.ByName("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()).WithDebug("could not parse UUID"))
+		return
+	}
+
+	var body AdminDeactivateIdentityBody
+	if err := errors.WithStack(jsonx.NewStrictDecoder(r.Body).Decode(&body)); err != nil {
+		h.r.Writer().WriteError(w, r, herodot.ErrBadRequest.WithReasonf("Unable to decode JSON payload: %s", err).WithWrap(err))
+		return
+	}
+
+	if body.ReactivateAt != nil && !body.ReactivateAt.After(time.Now()) {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("The reactivation time must be in the future.")))
+		return
+	}
+
+	i, err := h.r.PrivilegedIdentityPool().GetIdentityConfidential(r.Context(), iID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	i.Deactivate(body.Reason, body.ReactivateAt)
+	if err := h.r.PrivilegedIdentityPool().UpdateIdentity(r.Context(), i); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.SessionPersister().DeleteSessionsByIdentity(r.Context(), iID); err != nil && !errors.Is(err, sqlcon.ErrNoRows) {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, identity.WithAdminMetadataInJSON(*i))
+}
+
+// swagger:parameters adminDeleteIdentityCredentials
+// nolint:deadcode,unused
+type adminDeleteIdentityCredentials struct {
+	// ID is the identity's ID.
+	//
+	// required: true
+	// in: path
+	ID string `json:"id"`
+
+	// Type is the type of the credentials to delete, e.g. `password`, `oidc`, `totp`, `lookup_secret`, or `webauthn`.
+	//
+	// required: true
+	// in: path
+	Type string `json:"type"`
+
+	// Item
+	//
+	// The ID of a single linked OpenID Connect provider or WebAuthn security key to delete instead of the whole
+	// credentials, as listed by `GET /identities/{id}/credentials`.
+	//
+	// in: query
+	Item string `json:"item"`
+}
+
+// swagger:route DELETE /identities/{id}/credentials/{type} v0alpha2 adminDeleteIdentityCredentials
+//
+// Delete the Credentials of an Identity
+//
+// Calling this endpoint deletes the identity's credentials of the given type, for example to reset a lost second
+// factor. If `item` is set, only that linked OpenID Connect provider or WebAuthn security key is deleted and the
+// credentials are kept unless it was the last one.
+//
+// All sessions of the identity which were authenticated using the deleted credential type are revoked.
+//
+//     Schemes: http, https
+//
+//     Security:
+//       oryAccessToken:
+//
+//     Responses:
+//       204: emptyResponse
+//       400: jsonError
+//       404: jsonError
+//       500: jsonError
+func (h *Handler) deleteIdentityCredentials(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	iID, err := uuid.FromString(ps.ByName("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, herodot.ErrBadRequest.WithError(err.Error()).WithDebug("could not parse UUID"))
+		return
+	}
+
+	i, err := h.r.PrivilegedIdentityPool().GetIdentityConfidential(r.Context(), iID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	ct := identity.CredentialsType(ps.ByName("type"))
+	if _, ok := i.GetCredentials(ct); !ok {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrNotFound.WithReasonf("The identity has no %s credentials.", ct)))
+		return
+	}
+
+	if item := r.URL.Query().Get("item"); item != "" {
+		if err := i.DeleteCredentialsItem(ct, item); err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+	} else {
+		i.DeleteCredentialsType(ct)
+	}
+
+	if err := h.r.PrivilegedIdentityPool().UpdateIdentity(r.Context(), i); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if _, err := h.r.SessionPersister().DeleteSessionsByAuthenticationMethod(r.Context(), iID, ct); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *Handler) IsAuthenticated(wrap httprouter.Handle, onUnauthenticated httprouter.Handle) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		if _, err := h.r.SessionManager().FetchFromRequest(r.Context(), r); err != nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 
 	"github.com/ory/kratos/corpx"
 	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
@@ -102,6 +104,94 @@ func TestSessionWhoAmI(t *testing.T) {
 		})
 	})
 
+	t.Run("case=exposes public but not admin metadata", func(t *testing.T) {
+		conf.MustSet(config.ViperKeySessionWhoAmIAAL, "aal1")
+		i := createAAL1Identity(t, reg)
+		i.MetadataPublic = []byte(`{"plan":"pro"}`)
+		i.MetadataAdmin = []byte(`{"support":"priority"}`)
+		h, _ := testhelpers.MockSessionCreateHandlerWithIdentityAndAMR(t, reg, i, []identity.CredentialsType{identity.CredentialsTypePassword})
+		r.GET("/set/metadata", h)
+
+		client := testhelpers.NewClientWithCookies(t)
+		testhelpers.MockHydrateCookieClient(t, client, ts.URL+"/set/metadata")
+
+		res, err := client.Get(ts.URL + RouteWhoami)
+		require.NoError(t, err)
+		body := x.MustReadAll(res.Body)
+		require.EqualValues(t, http.StatusOK, res.StatusCode, "%s", body)
+		assert.JSONEq(t, `{"plan":"pro"}`, gjson.GetBytes(body, "identity.metadata_public").Raw, "%s", body)
+		assert.False(t, gjson.GetBytes(body, "identity.metadata_admin").Exists(), "%s", body)
+	})
+
+	t.Run("case=records the device", func(t *testing.T) {
+		conf.MustSet(config.ViperKeySessionWhoAmIAAL, "aal1")
+		h, sess := testhelpers.MockSessionCreateHandlerWithIdentityAndAMR(t, reg, createAAL1Identity(t, reg), []identity.CredentialsType{identity.CredentialsTypePassword})
+		r.GET("/set/device", h)
+
+		client := testhelpers.NewClientWithCookies(t)
+		testhelpers.MockHydrateCookieClient(t, client, ts.URL+"/set/device")
+
+		whoami := func(t *testing.T, ua string) string {
+			req, err := http.NewRequest("GET", ts.URL+RouteWhoami, nil)
+			require.NoError(t, err)
+			req.Header.Set("User-Agent", ua)
+			req.Header.Set("X-Device-Name", "My Laptop")
+
+			res, err := client.Do(req)
+			require.NoError(t, err)
+			body := x.MustReadAll(res.Body)
+			require.EqualValues(t, http.StatusOK, res.StatusCode, "%s", body)
+			return string(body)
+		}
+
+		body := whoami(t, "Mozilla/5.0")
+		assert.EqualValues(t, 1, gjson.Get(body, "devices.#").Int(), body)
+		assert.Equal(t, "127.0.0.1", gjson.Get(body, "devices.0.ip_address").String(), body)
+		assert.Equal(t, "Mozilla/5.0", gjson.Get(body, "devices.0.user_agent").String(), body)
+		assert.Equal(t, "My Laptop", gjson.Get(body, "devices.0.name").String(), body)
+		lastSeen := gjson.Get(body, "devices.0.last_seen_at").String()
+
+		body = whoami(t, "Mozilla/5.0")
+		assert.EqualValues(t, 1, gjson.Get(body, "devices.#").Int(), body)
+		assert.Equal(t, lastSeen, gjson.Get(body, "devices.0.last_seen_at").String(), "last seen is throttled")
+
+		body = whoami(t, "curl/7.79.1")
+		assert.EqualValues(t, 2, gjson.Get(body, "devices.#").Int(), body)
+		assert.Equal(t, "curl/7.79.1", gjson.Get(body, "devices.1.user_agent").String(), body)
+
+		actual, err := reg.SessionPersister().GetSession(context.Background(), sess.ID)
+		require.NoError(t, err)
+		assert.Len(t, actual.Devices, 2)
+	})
+
+	t.Run("case=rejects sessions of inactive identities", func(t *testing.T) {
+		conf.MustSet(config.ViperKeySessionWhoAmIAAL, "aal1")
+		i := createAAL1Identity(t, reg)
+		h, _ := testhelpers.MockSessionCreateHandlerWithIdentityAndAMR(t, reg, i, []identity.CredentialsType{identity.CredentialsTypePassword})
+		r.GET("/set/inactive", h)
+
+		client := testhelpers.NewClientWithCookies(t)
+		testhelpers.MockHydrateCookieClient(t, client, ts.URL+"/set/inactive")
+
+		deactivate := func(t *testing.T, reactivateAt *time.Time) {
+			i, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+			require.NoError(t, err)
+			i.Deactivate("suspicious activity", reactivateAt)
+			require.NoError(t, reg.PrivilegedIdentityPool().UpdateIdentity(context.Background(), i))
+		}
+
+		deactivate(t, nil)
+		res, err := client.Get(ts.URL + RouteWhoami)
+		require.NoError(t, err)
+		assert.EqualValues(t, http.StatusUnauthorized, res.StatusCode)
+
+		past := time.Now().Add(-time.Minute)
+		deactivate(t, &past)
+		res, err = client.Get(ts.URL + RouteWhoami)
+		require.NoError(t, err)
+		assert.EqualValues(t, http.StatusOK, res.StatusCode)
+	})
+
 	t.Run("case=http methods", func(t *testing.T) {
 		client := testhelpers.NewClientWithCookies(t)
 
@@ -399,3 +489,388 @@ func TestHandlerDeleteSessionByIdentityID(t *testing.T) {
 		require.Equal(t, http.StatusNotFound, res.StatusCode)
 	})
 }
+
+func TestHandlerDeactivateIdentity(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	_, ts, _, _ := testhelpers.NewKratosServerWithCSRFAndRouters(t, reg)
+
+	// set this intermediate because kratos needs some valid url for CRUDE operations
+	conf.MustSet(config.ViperKeyPublicBaseURL, "http://example.com")
+	testhelpers.SetDefaultIdentitySchema(t, conf, "file://./stub/identity.schema.json")
+	conf.MustSet(config.ViperKeyPublicBaseURL, ts.URL)
+
+	deactivate := func(t *testing.T, id string, body string, code int) []byte {
+		res, err := testhelpers.NewClientWithCookies(t).Post(ts.URL+"/identities/"+id+"/deactivate", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		actual := x.MustReadAll(res.Body)
+		require.Equal(t, code, res.StatusCode, "%s", actual)
+		return actual
+	}
+
+	t.Run("case=should deactivate the identity and revoke its sessions", func(t *testing.T) {
+		i := identity.NewIdentity("")
+		require.NoError(t, reg.IdentityManager().Create(context.Background(), i))
+		s := &Session{Identity: i}
+		require.NoError(t, reg.SessionPersister().UpsertSession(context.Background(), s))
+
+		reactivateAt := time.Now().Add(time.Hour).UTC().Round(time.Second)
+		body := deactivate(t, i.ID.String(), `{"reason":"suspicious activity","reactivate_at":"`+reactivateAt.Format(time.RFC3339)+`"}`, http.StatusOK)
+		assert.EqualValues(t, identity.StateInactive, gjson.GetBytes(body, "state").String(), "%s", body)
+		assert.EqualValues(t, "suspicious activity", gjson.GetBytes(body, "state_reason").String(), "%s", body)
+
+		actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+		require.NoError(t, err)
+		assert.EqualValues(t, identity.StateInactive, actual.State)
+		assert.EqualValues(t, "suspicious activity", actual.StateReason)
+		require.NotNil(t, actual.ReactivateAt)
+		assert.True(t, reactivateAt.Equal(time.Time(*actual.ReactivateAt)))
+		assert.False(t, actual.IsActive())
+
+		_, err = reg.SessionPersister().GetSession(context.Background(), s.ID)
+		require.True(t, errors.Is(err, sqlcon.ErrNoRows))
+	})
+
+	t.Run("case=should deactivate an identity without sessions", func(t *testing.T) {
+		i := identity.NewIdentity("")
+		require.NoError(t, reg.IdentityManager().Create(context.Background(), i))
+
+		body := deactivate(t, i.ID.String(), `{}`, http.StatusOK)
+		assert.EqualValues(t, identity.StateInactive, gjson.GetBytes(body, "state").String(), "%s", body)
+		assert.False(t, gjson.GetBytes(body, "reactivate_at").Exists(), "%s", body)
+	})
+
+	t.Run("case=should return 400 when the reactivation time is in the past", func(t *testing.T) {
+		i := identity.NewIdentity("")
+		require.NoError(t, reg.IdentityManager().Create(context.Background(), i))
+
+		deactivate(t, i.ID.String(), `{"reactivate_at":"`+time.Now().Add(-time.Hour).Format(time.RFC3339)+`"}`, http.StatusBadRequest)
+	})
+
+	t.Run("case=should return 400 when bad UUID is sent", func(t *testing.T) {
+		deactivate(t, "BADUUID", `{}`, http.StatusBadRequest)
+	})
+
+	t.Run("case=should return 404 when calling with missing UUID", func(t *testing.T) {
+		deactivate(t, x.NewUUID().String(), `{}`, http.StatusNotFound)
+	})
+}
+
+func TestHandlerDeleteIdentityCredentials(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	_, ts, _, _ := testhelpers.NewKratosServerWithCSRFAndRouters(t, reg)
+
+	// set this intermediate because kratos needs some valid url for CRUDE operations
+	conf.MustSet(config.ViperKeyPublicBaseURL, "http://example.com")
+	testhelpers.SetDefaultIdentitySchema(t, conf, "file://./stub/identity.schema.json")
+	conf.MustSet(config.ViperKeyPublicBaseURL, ts.URL)
+
+	remove := func(t *testing.T, path string, code int) {
+		req, err := http.NewRequest("DELETE", ts.URL+path, nil)
+		require.NoError(t, err)
+		res, err := testhelpers.NewClientWithCookies(t).Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, code, res.StatusCode, "%s", x.MustReadAll(res.Body))
+	}
+
+	newIdentity := func(t *testing.T) *identity.Identity {
+		i := identity.NewIdentity("")
+		subject := i.ID.String()
+		i.SetCredentials(identity.CredentialsTypeOIDC, identity.Credentials{
+			Identifiers: []string{"github:" + subject, "google:" + subject},
+			Config:      sqlxx.JSONRawMessage(`{"providers":[{"provider":"github","subject":"` + subject + `"},{"provider":"google","subject":"` + subject + `"}]}`),
+		})
+		i.SetCredentials(identity.CredentialsTypeTOTP, identity.Credentials{
+			Identifiers: []string{i.ID.String()},
+			Config:      sqlxx.JSONRawMessage(`{"totp_url":"otpauth://totp/foo"}`),
+		})
+		require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(context.Background(), i))
+		return i
+	}
+
+	newSession := func(t *testing.T, i *identity.Identity, methods ...identity.CredentialsType) *Session {
+		s := NewInactiveSession()
+		s.Identity = i
+		for _, m := range methods {
+			s.CompletedLoginFor(m)
+		}
+		require.NoError(t, reg.SessionPersister().UpsertSession(context.Background(), s))
+		return s
+	}
+
+	t.Run("case=should delete a single item and revoke the sessions using it", func(t *testing.T) {
+		i := newIdentity(t)
+		oidc := newSession(t, i, identity.CredentialsTypeOIDC)
+		totp := newSession(t, i, identity.CredentialsTypePassword, identity.CredentialsTypeTOTP)
+
+		remove(t, "/identities/"+i.ID.String()+"/credentials/oidc?item=github:"+i.ID.String(), http.StatusNoContent)
+
+		actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+		require.NoError(t, err)
+		c, ok := actual.GetCredentials(identity.CredentialsTypeOIDC)
+		require.True(t, ok)
+		assert.Equal(t, []string{"google:" + i.ID.String()}, c.Identifiers)
+		assert.Len(t, gjson.GetBytes(c.Config, "providers").Array(), 1)
+
+		_, err = reg.SessionPersister().GetSession(context.Background(), oidc.ID)
+		require.ErrorIs(t, err, sqlcon.ErrNoRows)
+		_, err = reg.SessionPersister().GetSession(context.Background(), totp.ID)
+		require.NoError(t, err)
+
+		remove(t, "/identities/"+i.ID.String()+"/credentials/oidc?item=github:"+i.ID.String(), http.StatusNotFound)
+	})
+
+	t.Run("case=should delete all credentials of a type", func(t *testing.T) {
+		i := newIdentity(t)
+		totp := newSession(t, i, identity.CredentialsTypePassword, identity.CredentialsTypeTOTP)
+
+		remove(t, "/identities/"+i.ID.String()+"/credentials/totp", http.StatusNoContent)
+
+		actual, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(context.Background(), i.ID)
+		require.NoError(t, err)
+		_, ok := actual.GetCredentials(identity.CredentialsTypeTOTP)
+		assert.False(t, ok)
+		_, ok = actual.GetCredentials(identity.CredentialsTypeOIDC)
+		assert.True(t, ok)
+
+		_, err = reg.SessionPersister().GetSession(context.Background(), totp.ID)
+		require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+		remove(t, "/identities/"+i.ID.String()+"/credentials/totp", http.StatusNotFound)
+	})
+
+	t.Run("case=should return 400 when deleting an item of credentials without items", func(t *testing.T) {
+		i := newIdentity(t)
+		remove(t, "/identities/"+i.ID.String()+"/credentials/totp?item=foo", http.StatusBadRequest)
+	})
+
+	t.Run("case=should return 400 when bad UUID is sent", func(t *testing.T) {
+		remove(t, "/identities/BADUUID/credentials/totp", http.StatusBadRequest)
+	})
+
+	t.Run("case=should return 404 when calling with missing UUID", func(t *testing.T) {
+		remove(t, "/identities/"+x.NewUUID().String()+"/credentials/totp", http.StatusNotFound)
+	})
+}
+
+func TestHandlerListAndRevokeSessions(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	publicTS, adminTS, _, _ := testhelpers.NewKratosServerWithCSRFAndRouters(t, reg)
+
+	// set this intermediate because kratos needs some valid url for CRUDE operations
+	conf.MustSet(config.ViperKeyPublicBaseURL, "http://example.com")
+	testhelpers.SetDefaultIdentitySchema(t, conf, "file://./stub/identity.schema.json")
+	conf.MustSet(config.ViperKeyPublicBaseURL, publicTS.URL)
+
+	ctx := context.Background()
+	newSession := func(t *testing.T, i *identity.Identity) *Session {
+		s, err := NewActiveSession(i, conf, time.Now().UTC(), identity.CredentialsTypePassword)
+		require.NoError(t, err)
+		require.NoError(t, reg.SessionPersister().UpsertSession(ctx, s))
+		return s
+	}
+
+	i := identity.NewIdentity("")
+	require.NoError(t, reg.IdentityManager().Create(ctx, i))
+	current, other1, other2 := newSession(t, i), newSession(t, i), newSession(t, i)
+
+	foreignIdentity := identity.NewIdentity("")
+	require.NoError(t, reg.IdentityManager().Create(ctx, foreignIdentity))
+	foreign := newSession(t, foreignIdentity)
+
+	do := func(t *testing.T, method, url, token string, code int) []byte {
+		req, err := http.NewRequest(method, url, nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("X-Session-Token", token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body := x.MustReadAll(res.Body)
+		require.Equal(t, code, res.StatusCode, "%s", body)
+		return body
+	}
+
+	listIDs := func(t *testing.T, body []byte) []string {
+		var ids []string
+		for _, id := range gjson.GetBytes(body, "#.id").Array() {
+			ids = append(ids, id.String())
+		}
+		return ids
+	}
+
+	t.Run("case=public", func(t *testing.T) {
+		t.Run("case=requires a session", func(t *testing.T) {
+			do(t, "GET", publicTS.URL+RouteCollection, "", http.StatusUnauthorized)
+			do(t, "DELETE", publicTS.URL+RouteCollection, "", http.StatusUnauthorized)
+			do(t, "DELETE", publicTS.URL+RouteCollection+"/"+other1.ID.String(), "", http.StatusUnauthorized)
+		})
+
+		t.Run("case=lists the active sessions of the identity", func(t *testing.T) {
+			body := do(t, "GET", publicTS.URL+RouteCollection, current.Token, http.StatusOK)
+			assert.ElementsMatch(t, []string{current.ID.String(), other1.ID.String(), other2.ID.String()}, listIDs(t, body))
+			assert.Equal(t, "aal1", gjson.GetBytes(body, "0.authenticator_assurance_level").String(), "%s", body)
+			assert.Equal(t, "password", gjson.GetBytes(body, "0.authentication_methods.0.method").String(), "%s", body)
+			assert.True(t, gjson.GetBytes(body, "0.issued_at").Exists(), "%s", body)
+			assert.False(t, gjson.GetBytes(body, "0.identity.credentials").Exists(), "%s", body)
+		})
+
+		t.Run("case=can not revoke the current session", func(t *testing.T) {
+			do(t, "DELETE", publicTS.URL+RouteCollection+"/"+current.ID.String(), current.Token, http.StatusBadRequest)
+		})
+
+		t.Run("case=can not revoke sessions of other identities", func(t *testing.T) {
+			do(t, "DELETE", publicTS.URL+RouteCollection+"/"+foreign.ID.String(), current.Token, http.StatusNotFound)
+
+			actual, err := reg.SessionPersister().GetSession(ctx, foreign.ID)
+			require.NoError(t, err)
+			assert.True(t, actual.Active)
+		})
+
+		t.Run("case=rejects invalid session IDs", func(t *testing.T) {
+			do(t, "DELETE", publicTS.URL+RouteCollection+"/not-a-uuid", current.Token, http.StatusBadRequest)
+		})
+
+		t.Run("case=still serves whoami", func(t *testing.T) {
+			body := do(t, "DELETE", publicTS.URL+RouteWhoami, current.Token, http.StatusOK)
+			assert.Equal(t, current.ID.String(), gjson.GetBytes(body, "id").String())
+		})
+
+		t.Run("case=revokes a session", func(t *testing.T) {
+			do(t, "DELETE", publicTS.URL+RouteCollection+"/"+other1.ID.String(), current.Token, http.StatusNoContent)
+
+			body := do(t, "GET", publicTS.URL+RouteCollection, current.Token, http.StatusOK)
+			assert.ElementsMatch(t, []string{current.ID.String(), other2.ID.String()}, listIDs(t, body))
+		})
+
+		t.Run("case=revokes all other sessions", func(t *testing.T) {
+			body := do(t, "DELETE", publicTS.URL+RouteCollection, current.Token, http.StatusOK)
+			assert.EqualValues(t, 1, gjson.GetBytes(body, "count").Int(), "%s", body)
+
+			body = do(t, "GET", publicTS.URL+RouteCollection, current.Token, http.StatusOK)
+			assert.Equal(t, []string{current.ID.String()}, listIDs(t, body))
+		})
+	})
+
+	t.Run("case=admin", func(t *testing.T) {
+		t.Run("case=lists the sessions of an identity", func(t *testing.T) {
+			for _, tc := range []struct {
+				query    string
+				expected []string
+			}{
+				{query: "", expected: []string{current.ID.String(), other1.ID.String(), other2.ID.String()}},
+				{query: "?active=true", expected: []string{current.ID.String()}},
+				{query: "?active=false", expected: []string{other1.ID.String(), other2.ID.String()}},
+			} {
+				t.Run("query="+tc.query, func(t *testing.T) {
+					body := do(t, "GET", adminTS.URL+"/identities/"+i.ID.String()+"/sessions"+tc.query, "", http.StatusOK)
+					assert.ElementsMatch(t, tc.expected, listIDs(t, body))
+				})
+			}
+		})
+
+		t.Run("case=paginates", func(t *testing.T) {
+			req, err := http.NewRequest("GET", adminTS.URL+"/identities/"+i.ID.String()+"/sessions?per_page=2", nil)
+			require.NoError(t, err)
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			assert.Len(t, listIDs(t, x.MustReadAll(res.Body)), 2)
+			assert.Contains(t, res.Header.Get("Link"), `rel="next"`)
+		})
+
+		t.Run("case=rejects invalid parameters", func(t *testing.T) {
+			do(t, "GET", adminTS.URL+"/identities/"+i.ID.String()+"/sessions?active=maybe", "", http.StatusBadRequest)
+			do(t, "GET", adminTS.URL+"/identities/not-a-uuid/sessions", "", http.StatusBadRequest)
+			do(t, "DELETE", adminTS.URL+RouteCollection+"/not-a-uuid", "", http.StatusBadRequest)
+		})
+
+		t.Run("case=returns 404 for unknown resources", func(t *testing.T) {
+			do(t, "GET", adminTS.URL+"/identities/"+x.NewUUID().String()+"/sessions", "", http.StatusNotFound)
+			do(t, "DELETE", adminTS.URL+RouteCollection+"/"+x.NewUUID().String(), "", http.StatusNotFound)
+		})
+
+		t.Run("case=revokes any session", func(t *testing.T) {
+			do(t, "DELETE", adminTS.URL+RouteCollection+"/"+foreign.ID.String(), "", http.StatusNoContent)
+
+			actual, err := reg.SessionPersister().GetSession(ctx, foreign.ID)
+			require.NoError(t, err)
+			assert.False(t, actual.Active)
+		})
+	})
+}
+
+func TestHandlerRefreshSession(t *testing.T) {
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	publicTS, _, _, _ := testhelpers.NewKratosServerWithCSRFAndRouters(t, reg)
+
+	// set this intermediate because kratos needs some valid url for CRUDE operations
+	conf.MustSet(config.ViperKeyPublicBaseURL, "http://example.com")
+	testhelpers.SetDefaultIdentitySchema(t, conf, "file://./stub/identity.schema.json")
+	conf.MustSet(config.ViperKeyPublicBaseURL, publicTS.URL)
+	conf.MustSet(config.ViperKeySessionLifespan, "1h")
+	conf.MustSet(config.ViperKeySessionIdleTimeout, "10m")
+	conf.MustSet(config.ViperKeySessionEarliestPossibleExtend, "5m")
+
+	ctx := context.Background()
+	i := identity.NewIdentity("")
+	require.NoError(t, reg.IdentityManager().Create(ctx, i))
+
+	s, err := NewActiveSession(i, conf, time.Now().UTC().Add(-30*time.Minute), identity.CredentialsTypePassword)
+	require.NoError(t, err)
+
+	expireIn := func(t *testing.T, d time.Duration) {
+		s.ExpiresAt = time.Now().UTC().Add(d)
+		require.NoError(t, reg.SessionPersister().UpsertSession(ctx, s))
+	}
+
+	do := func(t *testing.T, method, path string, code int) *Session {
+		req, err := http.NewRequest(method, publicTS.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Session-Token", s.Token)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body := x.MustReadAll(res.Body)
+		require.Equal(t, code, res.StatusCode, "%s", body)
+
+		actual, err := reg.SessionPersister().GetSession(ctx, s.ID)
+		require.NoError(t, err)
+		return actual
+	}
+
+	t.Run("case=refresh requires a session", func(t *testing.T) {
+		req, err := http.NewRequest("PATCH", publicTS.URL+RouteRefresh, nil)
+		require.NoError(t, err)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("case=whoami extends the session if it expires soon", func(t *testing.T) {
+		expireIn(t, time.Minute)
+		actual := do(t, "GET", RouteWhoami, http.StatusOK)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), actual.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("case=whoami does not extend the session outside of the earliest possible extend window", func(t *testing.T) {
+		expireIn(t, 8*time.Minute)
+		actual := do(t, "GET", RouteWhoami, http.StatusOK)
+		assert.WithinDuration(t, time.Now().Add(8*time.Minute), actual.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("case=refresh always extends the session", func(t *testing.T) {
+		expireIn(t, 8*time.Minute)
+		actual := do(t, "PATCH", RouteRefresh, http.StatusOK)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), actual.ExpiresAt, 5*time.Second)
+	})
+
+	t.Run("case=refresh does not extend the session beyond its lifespan", func(t *testing.T) {
+		s.AuthenticatedAt = time.Now().UTC().Add(-55 * time.Minute)
+		expireIn(t, time.Minute)
+		actual := do(t, "PATCH", RouteRefresh, http.StatusOK)
+		assert.WithinDuration(t, s.AuthenticatedAt.Add(time.Hour), actual.ExpiresAt, time.Second)
+	})
+}
@@ -81,6 +81,17 @@ type Manager interface {
 
 	// SessionAddAuthenticationMethod adds one or more authentication method to the session.
 	SessionAddAuthenticationMethod(ctx context.Context, sid uuid.UUID, method ...identity.CredentialsType) error
+
+	// ExtendSession moves the expiry of the session to `session.idle_timeout` from now, bounded by
+	// `session.lifespan`, and stores the session. It does nothing if the sliding expiration is disabled.
+	ExtendSession(ctx context.Context, s *Session) error
+
+	// ExtendSessionOnActivity calls ExtendSession if the session expires within `session.earliest_possible_extend`.
+	ExtendSessionOnActivity(ctx context.Context, s *Session) error
+
+	// RecordDeviceActivity updates when the device which sent the request was last seen using the session, or adds
+	// the device to the session if it is not yet known.
+	RecordDeviceActivity(ctx context.Context, r *http.Request, s *Session) error
 }
 
 type ManagementProvider interface {
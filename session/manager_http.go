@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/ory/x/urlx"
 
@@ -214,3 +215,45 @@ func (s *ManagerHTTP) SessionAddAuthenticationMethod(ctx context.Context, sid uu
 	sess.SetAuthenticatorAssuranceLevel()
 	return s.r.SessionPersister().UpsertSession(ctx, sess)
 }
+
+func (s *ManagerHTTP) ExtendSession(ctx context.Context, sess *Session) error {
+	expiresAt := sess.ExpiresAt
+	sess.Extend(s.r.Config(ctx))
+	if sess.ExpiresAt.Equal(expiresAt) {
+		return nil
+	}
+
+	return s.r.SessionPersister().UpsertSession(ctx, sess)
+}
+
+func (s *ManagerHTTP) ExtendSessionOnActivity(ctx context.Context, sess *Session) error {
+	if !sess.CanBeExtended(s.r.Config(ctx)) {
+		return nil
+	}
+
+	return s.ExtendSession(ctx, sess)
+}
+
+// The last seen timestamp of a device is updated at most once per deviceLastSeenInterval to avoid a database write
+// on every request.
+const deviceLastSeenInterval = time.Minute
+
+func (s *ManagerHTTP) RecordDeviceActivity(ctx context.Context, r *http.Request, sess *Session) error {
+	d := NewDevice(r, s.r.Config(ctx))
+	if known, found := sess.FindDevice(d); found {
+		if time.Since(known.LastSeenAt) < deviceLastSeenInterval {
+			return nil
+		}
+
+		known.LastSeenAt = d.LastSeenAt
+		return s.r.SessionPersister().UpsertSessionDevice(ctx, known)
+	}
+
+	d.SessionID = sess.ID
+	if err := s.r.SessionPersister().UpsertSessionDevice(ctx, &d); err != nil {
+		return err
+	}
+
+	sess.Devices = append(sess.Devices, d)
+	return nil
+}
@@ -31,6 +31,10 @@ type Persister interface {
 	// DeleteSessionsByIdentity removes all active session from the store for the given identity.
 	DeleteSessionsByIdentity(ctx context.Context, identity uuid.UUID) error
 
+	// DeleteSessionsByAuthenticationMethod removes all sessions of the given identity which were authenticated
+	// using the given method and returns the number of removed sessions.
+	DeleteSessionsByAuthenticationMethod(ctx context.Context, identity uuid.UUID, method identity.CredentialsType) (int, error)
+
 	// GetSessionByToken gets the session associated with the given token.
 	//
 	// Functionality is similar to GetSession but accepts a session token
@@ -45,6 +49,20 @@ type Persister interface {
 
 	// RevokeSessionByToken marks a session inactive with the given token.
 	RevokeSessionByToken(ctx context.Context, token string) error
+
+	// ListSessionsByIdentity returns the sessions of the given identity ordered by descending ID. If active is not
+	// nil, only sessions which are (or are not) active and unexpired are returned.
+	ListSessionsByIdentity(ctx context.Context, identity uuid.UUID, active *bool, page x.Page) ([]*Session, error)
+
+	// RevokeSession marks the session with the given ID inactive if it belongs to the given identity.
+	RevokeSession(ctx context.Context, identity, session uuid.UUID) error
+
+	// RevokeSessionsIdentityExcept marks all sessions of the given identity inactive except the given one
+	// and returns the number of revoked sessions.
+	RevokeSessionsIdentityExcept(ctx context.Context, identity, except uuid.UUID) (int, error)
+
+	// UpsertSessionDevice inserts a device of a session or updates when the device was last seen.
+	UpsertSessionDevice(ctx context.Context, d *Device) error
 }
 
 func TestPersister(ctx context.Context, conf *config.Config, p interface {
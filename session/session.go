@@ -5,6 +5,9 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -22,6 +25,12 @@ var ErrIdentityDisabled = herodot.ErrUnauthorized.WithError("identity is disable
 
 type lifespanProvider interface {
 	SessionLifespan() time.Duration
+	SessionIdleTimeout() time.Duration
+}
+
+type extendProvider interface {
+	lifespanProvider
+	SessionEarliestPossibleExtend() time.Duration
 }
 
 // A Session
@@ -85,6 +94,9 @@ type Session struct {
 	// UpdatedAt is a helper struct field for gobuffalo.pop.
 	UpdatedAt time.Time `json:"-" faker:"-" db:"updated_at"`
 
+	// Devices which used this session.
+	Devices []Device `json:"devices" faker:"-" has_many:"session_devices" fk_id:"session_id"`
+
 	// The Session Token
 	//
 	// The token of this session.
@@ -101,6 +113,16 @@ func (s *Session) CompletedLoginFor(method identity.CredentialsType) {
 		AuthenticationMethod{Method: method, CompletedAt: time.Now().UTC()})
 }
 
+// AuthenticatedWith returns true if the given method was used to authenticate this session.
+func (s *Session) AuthenticatedWith(method identity.CredentialsType) bool {
+	for _, m := range s.AMR {
+		if m.Method == method {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Session) SetAuthenticatorAssuranceLevel() {
 	cts := make([]identity.CredentialsType, len(s.AMR))
 	for k := range s.AMR {
@@ -135,8 +157,8 @@ func (s *Session) Activate(i *identity.Identity, c lifespanProvider, authenticat
 	}
 
 	s.Active = true
-	s.ExpiresAt = authenticatedAt.Add(c.SessionLifespan())
 	s.AuthenticatedAt = authenticatedAt
+	s.ExpiresAt = s.expiresAt(c, authenticatedAt)
 	s.IssuedAt = authenticatedAt
 	s.Identity = i
 	s.IdentityID = i.ID
@@ -145,10 +167,127 @@ func (s *Session) Activate(i *identity.Identity, c lifespanProvider, authenticat
 	return nil
 }
 
+// expiresAt returns when the session expires if it was last used at the given time. Sessions expire
+// `session.idle_timeout` after they were last used but never later than `session.lifespan` after
+// they were authenticated.
+func (s *Session) expiresAt(c lifespanProvider, usedAt time.Time) time.Time {
+	expiresAt := s.AuthenticatedAt.Add(c.SessionLifespan())
+	if idle := c.SessionIdleTimeout(); idle > 0 && usedAt.Add(idle).Before(expiresAt) {
+		return usedAt.Add(idle)
+	}
+	return expiresAt
+}
+
+// CanBeExtended returns true if the session uses a sliding expiration, expires within the
+// `session.earliest_possible_extend` window, and has not yet reached its maximum lifespan.
+func (s *Session) CanBeExtended(c extendProvider) bool {
+	if c.SessionIdleTimeout() <= 0 {
+		return false
+	}
+
+	return time.Until(s.ExpiresAt) <= c.SessionEarliestPossibleExtend() &&
+		s.expiresAt(c, time.Now().UTC()).After(s.ExpiresAt)
+}
+
+// Extend moves the expiry of the session to `session.idle_timeout` from now, bounded by the maximum
+// lifespan of the session. It does nothing if the sliding expiration is disabled.
+func (s *Session) Extend(c lifespanProvider) {
+	if c.SessionIdleTimeout() <= 0 {
+		return
+	}
+
+	if expiresAt := s.expiresAt(c, time.Now().UTC()); expiresAt.After(s.ExpiresAt) {
+		s.ExpiresAt = expiresAt
+	}
+}
+
+// Device corresponding to a Session
+//
 // swagger:model sessionDevice
 type Device struct {
-	// UserAgent of this device
-	UserAgent string `json:"user_agent"`
+	// Device record ID
+	//
+	// required: true
+	ID uuid.UUID `json:"id" faker:"-" db:"id"`
+
+	// SessionID is a helper struct field for gobuffalo.pop.
+	SessionID uuid.UUID `json:"-" faker:"-" db:"session_id"`
+
+	// IPAddress of the client
+	IPAddress string `json:"ip_address" db:"ip_address"`
+
+	// UserAgent of the client
+	UserAgent string `json:"user_agent" db:"user_agent"`
+
+	// Name of the device as set by the client using the `X-Device-Name` HTTP header
+	Name string `json:"name,omitempty" db:"name"`
+
+	// The Device Last Seen Timestamp
+	//
+	// When this device was last seen using the session.
+	LastSeenAt time.Time `json:"last_seen_at" db:"last_seen_at" faker:"time_type"`
+
+	// CreatedAt is a helper struct field for gobuffalo.pop.
+	CreatedAt time.Time `json:"created_at" faker:"-" db:"created_at"`
+
+	// UpdatedAt is a helper struct field for gobuffalo.pop.
+	UpdatedAt time.Time `json:"-" faker:"-" db:"updated_at"`
+
+	NID uuid.UUID `json:"-" faker:"-" db:"nid"`
+}
+
+func (d Device) TableName(ctx context.Context) string {
+	return corp.ContextualizeTableName(ctx, "session_devices")
+}
+
+type deviceProvider interface {
+	PublicTrustedProxies() []*net.IPNet
+}
+
+// NewDevice returns the device which sent the request. The client IP address is determined using
+// `serve.public.trusted_proxies`.
+func NewDevice(r *http.Request, c deviceProvider) Device {
+	return Device{
+		ID:         x.NewUUID(),
+		IPAddress:  x.ClientIP(r, c.PublicTrustedProxies()),
+		UserAgent:  truncate(r.UserAgent(), maxDeviceFieldLength),
+		Name:       truncate(strings.TrimSpace(r.Header.Get("X-Device-Name")), maxDeviceFieldLength),
+		LastSeenAt: time.Now().UTC(),
+	}
+}
+
+// The user agent and device name are stored in fields of this size.
+const maxDeviceFieldLength = 512
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+// AddDevice records the device which sent the request unless it is already known. Devices are stored
+// when the session is upserted.
+func (s *Session) AddDevice(r *http.Request, c deviceProvider) {
+	d := NewDevice(r, c)
+	if _, found := s.FindDevice(d); found {
+		return
+	}
+
+	d.SessionID = s.ID
+	s.Devices = append(s.Devices, d)
+}
+
+// FindDevice returns the device of the session matching the device which sent the request.
+func (s *Session) FindDevice(d Device) (*Device, bool) {
+	for k := range s.Devices {
+		if s.Devices[k].IPAddress == d.IPAddress &&
+			s.Devices[k].UserAgent == d.UserAgent &&
+			s.Devices[k].Name == d.Name {
+			return &s.Devices[k], true
+		}
+	}
+	return nil, false
 }
 
 func (s *Session) Declassify() *Session {
@@ -1,13 +1,18 @@
 package session_test
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ory/kratos/driver/config"
 	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/internal"
 	"github.com/ory/kratos/session"
@@ -60,6 +65,121 @@ func TestSession(t *testing.T) {
 		assert.Empty(t, s.AuthenticatedAt)
 	})
 
+	t.Run("case=sliding expiration", func(t *testing.T) {
+		conf.MustSet(config.ViperKeySessionLifespan, "1h")
+		t.Cleanup(func() {
+			conf.MustSet(config.ViperKeySessionLifespan, "24h")
+			conf.MustSet(config.ViperKeySessionIdleTimeout, "")
+			conf.MustSet(config.ViperKeySessionEarliestPossibleExtend, "")
+		})
+
+		active := &identity.Identity{State: identity.StateActive}
+
+		t.Run("case=disabled", func(t *testing.T) {
+			s, err := session.NewActiveSession(active, conf, authAt, identity.CredentialsTypePassword)
+			require.NoError(t, err)
+			assert.Equal(t, authAt.Add(time.Hour), s.ExpiresAt)
+			assert.False(t, s.CanBeExtended(conf))
+
+			s.Extend(conf)
+			assert.Equal(t, authAt.Add(time.Hour), s.ExpiresAt)
+		})
+
+		conf.MustSet(config.ViperKeySessionIdleTimeout, "10m")
+
+		t.Run("case=expires after the idle timeout", func(t *testing.T) {
+			s, err := session.NewActiveSession(active, conf, authAt, identity.CredentialsTypePassword)
+			require.NoError(t, err)
+			assert.Equal(t, authAt.Add(10*time.Minute), s.ExpiresAt)
+		})
+
+		t.Run("case=extends on activity", func(t *testing.T) {
+			s, err := session.NewActiveSession(active, conf, authAt.Add(-30*time.Minute), identity.CredentialsTypePassword)
+			require.NoError(t, err)
+			s.ExpiresAt = time.Now().Add(time.Minute)
+			assert.True(t, s.CanBeExtended(conf))
+
+			s.Extend(conf)
+			assert.WithinDuration(t, time.Now().Add(10*time.Minute), s.ExpiresAt, time.Second)
+		})
+
+		t.Run("case=never extends beyond the lifespan", func(t *testing.T) {
+			s, err := session.NewActiveSession(active, conf, time.Now().Add(-55*time.Minute), identity.CredentialsTypePassword)
+			require.NoError(t, err)
+			s.ExpiresAt = time.Now().Add(time.Minute)
+
+			s.Extend(conf)
+			assert.Equal(t, s.AuthenticatedAt.Add(time.Hour), s.ExpiresAt)
+			assert.False(t, s.CanBeExtended(conf))
+		})
+
+		t.Run("case=respects the earliest possible extend window", func(t *testing.T) {
+			conf.MustSet(config.ViperKeySessionEarliestPossibleExtend, "5m")
+
+			s, err := session.NewActiveSession(active, conf, time.Now(), identity.CredentialsTypePassword)
+			require.NoError(t, err)
+			assert.False(t, s.CanBeExtended(conf), "expires in 10 minutes which is outside of the window")
+
+			s.ExpiresAt = time.Now().Add(4 * time.Minute)
+			assert.True(t, s.CanBeExtended(conf))
+		})
+	})
+
+	t.Run("case=devices", func(t *testing.T) {
+		newRequest := func(remote, forwarded string) *http.Request {
+			r := httptest.NewRequest("GET", "/sessions/whoami", nil)
+			r.RemoteAddr = remote
+			r.Header.Set("User-Agent", "Mozilla/5.0")
+			r.Header.Set("X-Device-Name", " My Laptop ")
+			if forwarded != "" {
+				r.Header.Set("X-Forwarded-For", forwarded)
+			}
+			return r
+		}
+
+		t.Run("case=records the request", func(t *testing.T) {
+			d := session.NewDevice(newRequest("1.2.3.4:1234", ""), conf)
+			assert.NotEqual(t, uuid.Nil, d.ID)
+			assert.Equal(t, "1.2.3.4", d.IPAddress)
+			assert.Equal(t, "Mozilla/5.0", d.UserAgent)
+			assert.Equal(t, "My Laptop", d.Name)
+			assert.WithinDuration(t, time.Now(), d.LastSeenAt, time.Minute)
+		})
+
+		t.Run("case=respects trusted proxies", func(t *testing.T) {
+			assert.Equal(t, "127.0.0.1", session.NewDevice(newRequest("127.0.0.1:1234", "1.2.3.4"), conf).IPAddress)
+
+			conf.MustSet(config.ViperKeyPublicTrustedProxies, []string{"127.0.0.1"})
+			t.Cleanup(func() {
+				conf.MustSet(config.ViperKeyPublicTrustedProxies, []string{})
+			})
+			assert.Equal(t, "1.2.3.4", session.NewDevice(newRequest("127.0.0.1:1234", "1.2.3.4"), conf).IPAddress)
+		})
+
+		t.Run("case=truncates long values", func(t *testing.T) {
+			r := newRequest("1.2.3.4:1234", "")
+			r.Header.Set("User-Agent", strings.Repeat("a", 1024))
+			assert.Len(t, session.NewDevice(r, conf).UserAgent, 512)
+		})
+
+		t.Run("case=adds unknown devices only", func(t *testing.T) {
+			s := session.NewInactiveSession()
+			s.AddDevice(newRequest("1.2.3.4:1234", ""), conf)
+			s.AddDevice(newRequest("1.2.3.4:4321", ""), conf)
+			require.Len(t, s.Devices, 1)
+			assert.Equal(t, s.ID, s.Devices[0].SessionID)
+
+			s.AddDevice(newRequest("5.6.7.8:1234", ""), conf)
+			require.Len(t, s.Devices, 2)
+			assert.Equal(t, "5.6.7.8", s.Devices[1].IPAddress)
+
+			_, found := s.FindDevice(session.NewDevice(newRequest("5.6.7.8:1234", ""), conf))
+			assert.True(t, found)
+			_, found = s.FindDevice(session.NewDevice(newRequest("9.9.9.9:1234", ""), conf))
+			assert.False(t, found)
+		})
+	})
+
 	t.Run("case=aal", func(t *testing.T) {
 		for _, tc := range []struct {
 			d        string
@@ -17,6 +17,7 @@ import (
 	"github.com/ory/kratos/persistence"
 	"github.com/ory/kratos/session"
 	"github.com/ory/kratos/x"
+	"github.com/ory/x/pointerx"
 	"github.com/ory/x/randx"
 	"github.com/ory/x/sqlcon"
 )
@@ -199,6 +200,252 @@ func TestPersister(ctx context.Context, conf *config.Config, p interface {
 			require.Error(t, err)
 		})
 
+		t.Run("case=delete sessions by authentication method", func(t *testing.T) {
+			var password, webauthn session.Session
+			require.NoError(t, faker.FakeData(&password))
+			require.NoError(t, p.CreateIdentity(ctx, password.Identity))
+			password.AMR = session.AuthenticationMethods{{Method: identity.CredentialsTypePassword}}
+			require.NoError(t, p.UpsertSession(ctx, &password))
+
+			require.NoError(t, faker.FakeData(&webauthn))
+			webauthn.Identity = password.Identity
+			webauthn.IdentityID = password.IdentityID
+			webauthn.AMR = session.AuthenticationMethods{{Method: identity.CredentialsTypePassword}, {Method: identity.CredentialsTypeWebAuthn}}
+			require.NoError(t, p.UpsertSession(ctx, &webauthn))
+
+			t.Run("on another network", func(t *testing.T) {
+				_, other := testhelpers.NewNetwork(t, ctx, p)
+				count, err := other.DeleteSessionsByAuthenticationMethod(ctx, password.IdentityID, identity.CredentialsTypeWebAuthn)
+				require.NoError(t, err)
+				assert.Equal(t, 0, count)
+			})
+
+			count, err := p.DeleteSessionsByAuthenticationMethod(ctx, password.IdentityID, identity.CredentialsTypeWebAuthn)
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			_, err = p.GetSession(ctx, password.ID)
+			require.NoError(t, err)
+			_, err = p.GetSession(ctx, webauthn.ID)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+		})
+
+		t.Run("case=list sessions by identity", func(t *testing.T) {
+			var active, expired, revoked session.Session
+			require.NoError(t, faker.FakeData(&active))
+			active.Active = true
+			active.ExpiresAt = time.Now().Add(time.Hour).UTC()
+			require.NoError(t, p.CreateIdentity(ctx, active.Identity))
+			require.NoError(t, p.UpsertSession(ctx, &active))
+
+			for _, s := range []*session.Session{&expired, &revoked} {
+				require.NoError(t, faker.FakeData(s))
+				s.Identity = active.Identity
+				s.IdentityID = active.IdentityID
+			}
+			expired.Active = true
+			expired.ExpiresAt = time.Now().Add(-time.Hour).UTC()
+			require.NoError(t, p.UpsertSession(ctx, &expired))
+			revoked.Active = false
+			revoked.ExpiresAt = time.Now().Add(time.Hour).UTC()
+			require.NoError(t, p.UpsertSession(ctx, &revoked))
+
+			ids := func(ss []*session.Session) []uuid.UUID {
+				out := make([]uuid.UUID, len(ss))
+				for k, s := range ss {
+					assert.Equal(t, active.IdentityID, s.Identity.ID)
+					out[k] = s.ID
+				}
+				return out
+			}
+
+			t.Run("filter=all", func(t *testing.T) {
+				actual, err := p.ListSessionsByIdentity(ctx, active.IdentityID, nil, x.KeysetPage(10))
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []uuid.UUID{active.ID, expired.ID, revoked.ID}, ids(actual))
+			})
+
+			t.Run("filter=active", func(t *testing.T) {
+				actual, err := p.ListSessionsByIdentity(ctx, active.IdentityID, pointerx.Bool(true), x.KeysetPage(10))
+				require.NoError(t, err)
+				assert.Equal(t, []uuid.UUID{active.ID}, ids(actual))
+			})
+
+			t.Run("filter=inactive", func(t *testing.T) {
+				actual, err := p.ListSessionsByIdentity(ctx, active.IdentityID, pointerx.Bool(false), x.KeysetPage(10))
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []uuid.UUID{expired.ID, revoked.ID}, ids(actual))
+			})
+
+			t.Run("case=paginates", func(t *testing.T) {
+				var all []uuid.UUID
+				page := x.KeysetPage(1)
+				for i := 0; i < 4; i++ {
+					actual, err := p.ListSessionsByIdentity(ctx, active.IdentityID, nil, page)
+					require.NoError(t, err)
+					if len(actual) == 0 {
+						break
+					}
+					require.Len(t, actual, 1)
+					all = append(all, actual[0].ID)
+					page = page.Next(actual[0].ID)
+				}
+				assert.ElementsMatch(t, []uuid.UUID{active.ID, expired.ID, revoked.ID}, all)
+			})
+
+			t.Run("case=unknown identity", func(t *testing.T) {
+				_, err := p.ListSessionsByIdentity(ctx, x.NewUUID(), nil, x.KeysetPage(10))
+				assert.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
+			t.Run("on another network", func(t *testing.T) {
+				_, other := testhelpers.NewNetwork(t, ctx, p)
+				_, err := other.ListSessionsByIdentity(ctx, active.IdentityID, nil, x.KeysetPage(10))
+				assert.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+		})
+
+		t.Run("case=revoke session", func(t *testing.T) {
+			var expected session.Session
+			require.NoError(t, faker.FakeData(&expected))
+			expected.Active = true
+			require.NoError(t, p.CreateIdentity(ctx, expected.Identity))
+			require.NoError(t, p.UpsertSession(ctx, &expected))
+
+			t.Run("on another network", func(t *testing.T) {
+				_, other := testhelpers.NewNetwork(t, ctx, p)
+				err := other.RevokeSession(ctx, expected.IdentityID, expected.ID)
+				assert.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
+			t.Run("case=other identity", func(t *testing.T) {
+				err := p.RevokeSession(ctx, x.NewUUID(), expected.ID)
+				assert.ErrorIs(t, err, sqlcon.ErrNoRows)
+			})
+
+			actual, err := p.GetSession(ctx, expected.ID)
+			require.NoError(t, err)
+			assert.True(t, actual.Active)
+
+			require.NoError(t, p.RevokeSession(ctx, expected.IdentityID, expected.ID))
+
+			actual, err = p.GetSession(ctx, expected.ID)
+			require.NoError(t, err)
+			assert.False(t, actual.Active)
+		})
+
+		t.Run("case=revoke sessions except", func(t *testing.T) {
+			var current, other session.Session
+			require.NoError(t, faker.FakeData(&current))
+			current.Active = true
+			require.NoError(t, p.CreateIdentity(ctx, current.Identity))
+			require.NoError(t, p.UpsertSession(ctx, &current))
+
+			require.NoError(t, faker.FakeData(&other))
+			other.Active = true
+			other.Identity = current.Identity
+			other.IdentityID = current.IdentityID
+			require.NoError(t, p.UpsertSession(ctx, &other))
+
+			t.Run("on another network", func(t *testing.T) {
+				_, otherNetwork := testhelpers.NewNetwork(t, ctx, p)
+				count, err := otherNetwork.RevokeSessionsIdentityExcept(ctx, current.IdentityID, current.ID)
+				require.NoError(t, err)
+				assert.Equal(t, 0, count)
+			})
+
+			count, err := p.RevokeSessionsIdentityExcept(ctx, current.IdentityID, current.ID)
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+
+			actual, err := p.GetSession(ctx, current.ID)
+			require.NoError(t, err)
+			assert.True(t, actual.Active)
+
+			actual, err = p.GetSession(ctx, other.ID)
+			require.NoError(t, err)
+			assert.False(t, actual.Active)
+		})
+
+		t.Run("case=session devices", func(t *testing.T) {
+			var expected session.Session
+			require.NoError(t, faker.FakeData(&expected))
+			expected.Active = true
+			expected.Devices = []session.Device{{
+				ID:         x.NewUUID(),
+				IPAddress:  "127.0.0.1",
+				UserAgent:  "Mozilla/5.0",
+				Name:       "My Laptop",
+				LastSeenAt: time.Now().UTC().Add(-time.Hour).Round(time.Second),
+			}}
+			require.NoError(t, p.CreateIdentity(ctx, expected.Identity))
+			require.NoError(t, p.UpsertSession(ctx, &expected))
+
+			check := func(t *testing.T, expected []session.Device, actual *session.Session) {
+				require.Len(t, actual.Devices, len(expected))
+				for k := range expected {
+					assert.Equal(t, expected[k].ID, actual.Devices[k].ID)
+					assert.Equal(t, actual.ID, actual.Devices[k].SessionID)
+					assert.Equal(t, expected[k].IPAddress, actual.Devices[k].IPAddress)
+					assert.Equal(t, expected[k].UserAgent, actual.Devices[k].UserAgent)
+					assert.Equal(t, expected[k].Name, actual.Devices[k].Name)
+					assert.Equal(t, expected[k].LastSeenAt.Unix(), actual.Devices[k].LastSeenAt.Unix())
+				}
+			}
+
+			actual, err := p.GetSession(ctx, expected.ID)
+			require.NoError(t, err)
+			check(t, expected.Devices, actual)
+
+			t.Run("case=upserting the session does not duplicate devices", func(t *testing.T) {
+				require.NoError(t, p.UpsertSession(ctx, actual))
+				actual, err := p.GetSessionByToken(ctx, expected.Token)
+				require.NoError(t, err)
+				check(t, expected.Devices, actual)
+			})
+
+			t.Run("case=update last seen", func(t *testing.T) {
+				d := actual.Devices[0]
+				d.LastSeenAt = time.Now().UTC().Round(time.Second)
+				require.NoError(t, p.UpsertSessionDevice(ctx, &d))
+				expected.Devices[0].LastSeenAt = d.LastSeenAt
+
+				actual, err := p.GetSession(ctx, expected.ID)
+				require.NoError(t, err)
+				check(t, expected.Devices, actual)
+			})
+
+			t.Run("case=add device", func(t *testing.T) {
+				d := session.Device{
+					ID:         x.NewUUID(),
+					SessionID:  expected.ID,
+					IPAddress:  "::1",
+					UserAgent:  "curl/7.79.1",
+					LastSeenAt: time.Now().UTC().Round(time.Second),
+				}
+				require.NoError(t, p.UpsertSessionDevice(ctx, &d))
+				expected.Devices = append(expected.Devices, d)
+
+				actual, err := p.GetSession(ctx, expected.ID)
+				require.NoError(t, err)
+				check(t, expected.Devices, actual)
+			})
+
+			t.Run("case=list sessions", func(t *testing.T) {
+				ss, err := p.ListSessionsByIdentity(ctx, expected.IdentityID, nil, x.KeysetPage(10))
+				require.NoError(t, err)
+				require.Len(t, ss, 1)
+				check(t, expected.Devices, ss[0])
+			})
+
+			t.Run("on another network", func(t *testing.T) {
+				_, other := testhelpers.NewNetwork(t, ctx, p)
+				d := expected.Devices[0]
+				d.LastSeenAt = time.Now().UTC().Add(time.Hour)
+				require.Error(t, other.UpsertSessionDevice(ctx, &d))
+			})
+		})
+
 		t.Run("network isolation", func(t *testing.T) {
 			nid1, p := testhelpers.NewNetwork(t, ctx, p)
 			nid2, _ := testhelpers.NewNetwork(t, ctx, p)
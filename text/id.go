@@ -59,6 +59,7 @@ const (
 	InfoSelfServiceRecovery           ID = 1060000 + iota // 1060000
 	InfoSelfServiceRecoverySuccessful                     // 1060001
 	InfoSelfServiceRecoveryEmailSent                      // 1060002
+	InfoSelfServiceRecoverySMSSent                        // 1060003
 )
 
 const (
@@ -70,12 +71,15 @@ const (
 	InfoNodeLabelSubmit                            // 1070005
 	InfoNodeLabelVerifyOTP                         // 1070006
 	InfoNodeLabelEmail                             // 1070007
+	InfoNodeLabelPhone                             // 1070008
+	InfoNodeLabelCode                              // 1070009
 )
 
 const (
 	InfoSelfServiceVerification           ID = 1080000 + iota // 1080000
 	InfoSelfServiceVerificationEmailSent                      // 1080001
 	InfoSelfServiceVerificationSuccessful                     // 1080002
+	InfoSelfServiceVerificationSMSSent                        // 1080003
 )
 
 const (
@@ -94,6 +98,9 @@ const (
 	ErrorValidationLookupAlreadyUsed
 	ErrorValidationNoWebAuthnDevice
 	ErrorValidationNoLookup
+	ErrorValidationIdentityDisabled
+	ErrorValidationDuplicateTrait
+	ErrorValidationCodeInvalid
 )
 
 const (
@@ -114,6 +121,7 @@ const (
 const (
 	ErrorValidationSettings ID = 4050000 + iota
 	ErrorValidationSettingsFlowExpired
+	ErrorValidationSettingsIdentityModified
 )
 
 const (
@@ -6,6 +6,7 @@ const (
 	ErrIDNeedsPrivilegedSession                        = "session_refresh_required"
 	ErrIDSelfServiceFlowExpired                        = "self_service_flow_expired"
 	ErrIDSelfServiceBrowserLocationChangeRequiredError = "browser_location_change_required"
+	ErrIDIdentityModified                              = "identity_modified"
 
 	ErrIDAlreadyLoggedIn             = "session_already_available"
 	ErrIDAddressNotVerified          = "session_verified_address_required"
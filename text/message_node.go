@@ -55,3 +55,19 @@ func NewInfoNodeInputEmail() *Message {
 		Type: Info,
 	}
 }
+
+func NewInfoNodeInputPhone() *Message {
+	return &Message{
+		ID:   InfoNodeLabelPhone,
+		Text: "Phone number",
+		Type: Info,
+	}
+}
+
+func NewInfoNodeInputCode() *Message {
+	return &Message{
+		ID:   InfoNodeLabelCode,
+		Text: "Code",
+		Type: Info,
+	}
+}
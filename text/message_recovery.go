@@ -37,6 +37,15 @@ func NewRecoveryEmailSent() *Message {
 	}
 }
 
+func NewRecoverySMSSent() *Message {
+	return &Message{
+		ID:      InfoSelfServiceRecoverySMSSent,
+		Type:    Info,
+		Text:    "An SMS containing a recovery code has been sent to the phone number you provided.",
+		Context: context(nil),
+	}
+}
+
 func NewErrorValidationRecoveryTokenInvalidOrAlreadyUsed() *Message {
 	return &Message{
 		ID:      ErrorValidationRecoveryTokenInvalidOrAlreadyUsed,
@@ -17,6 +17,15 @@ func NewErrorValidationSettingsFlowExpired(ago time.Duration) *Message {
 	}
 }
 
+func NewErrorValidationSettingsIdentityModified() *Message {
+	return &Message{
+		ID:      ErrorValidationSettingsIdentityModified,
+		Text:    "Your settings were changed elsewhere while you were editing them. Please review the updated settings and try again.",
+		Type:    Error,
+		Context: context(nil),
+	}
+}
+
 func NewInfoSelfServiceSettingsTOTPQRCode() *Message {
 	return &Message{
 		ID:   InfoSelfServiceSettingsTOTPQRCode,
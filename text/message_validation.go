@@ -146,3 +146,32 @@ func NewErrorValidationNoWebAuthnDevice() *Message {
 		Context: context(nil),
 	}
 }
+
+func NewErrorValidationIdentityDisabled() *Message {
+	return &Message{
+		ID:      ErrorValidationIdentityDisabled,
+		Text:    "This account was disabled.",
+		Type:    Error,
+		Context: context(nil),
+	}
+}
+
+func NewErrorValidationDuplicateTrait(value string) *Message {
+	return &Message{
+		ID:   ErrorValidationDuplicateTrait,
+		Text: fmt.Sprintf("An account with the same value %q exists already.", value),
+		Type: Error,
+		Context: context(map[string]interface{}{
+			"value": value,
+		}),
+	}
+}
+
+func NewErrorValidationCodeInvalid() *Message {
+	return &Message{
+		ID:      ErrorValidationCodeInvalid,
+		Text:    "The code is invalid or has already been used. Please try again or request a new code.",
+		Type:    Error,
+		Context: context(nil),
+	}
+}
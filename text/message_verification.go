@@ -33,6 +33,15 @@ func NewVerificationEmailSent() *Message {
 	}
 }
 
+func NewVerificationSMSSent() *Message {
+	return &Message{
+		ID:      InfoSelfServiceVerificationSMSSent,
+		Type:    Info,
+		Text:    "An SMS containing a verification code has been sent to the phone number you provided.",
+		Context: context(nil),
+	}
+}
+
 func NewErrorValidationVerificationTokenInvalidOrAlreadyUsed() *Message {
 	return &Message{
 		ID:      ErrorValidationVerificationTokenInvalidOrAlreadyUsed,
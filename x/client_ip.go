@@ -0,0 +1,58 @@
+package x
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ClientIP returns the IP address of the client which sent the request.
+//
+// The `X-Forwarded-For` header is only used if the request was received from one of the trusted proxies. In that
+// case, the header is read from right to left and the first address which is not a trusted proxy is returned.
+func ClientIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	remote := remoteIP(r.RemoteAddr)
+	if remote == nil {
+		return r.RemoteAddr
+	}
+
+	if !isTrustedProxy(remote, trustedProxies) {
+		return remote.String()
+	}
+
+	var forwarded []string
+	for _, h := range r.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(h, ",")...)
+	}
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if ip == nil {
+			// The header was tampered with, everything to the left of this entry is untrusted.
+			break
+		}
+
+		remote = ip
+		if !isTrustedProxy(ip, trustedProxies) {
+			break
+		}
+	}
+
+	return remote.String()
+}
+
+func remoteIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
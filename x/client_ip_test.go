@@ -0,0 +1,48 @@
+package x
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIP(t *testing.T) {
+	_, private, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	_, loopback, err := net.ParseCIDR("127.0.0.1/32")
+	require.NoError(t, err)
+	trusted := []*net.IPNet{private, loopback}
+
+	for k, tc := range []struct {
+		remote    string
+		forwarded []string
+		trusted   []*net.IPNet
+		e         string
+	}{
+		{remote: "1.2.3.4:1234", e: "1.2.3.4"},
+		{remote: "[2001:db8::1]:1234", e: "2001:db8::1"},
+		{remote: "1.2.3.4", e: "1.2.3.4"},
+		{remote: "not-an-ip", e: "not-an-ip"},
+		{remote: "1.2.3.4:1234", forwarded: []string{"5.6.7.8"}, e: "1.2.3.4"},
+		{remote: "1.2.3.4:1234", forwarded: []string{"5.6.7.8"}, trusted: trusted, e: "1.2.3.4"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"5.6.7.8"}, e: "10.0.0.1"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"5.6.7.8"}, trusted: trusted, e: "5.6.7.8"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"9.9.9.9, 5.6.7.8, 10.0.0.2"}, trusted: trusted, e: "5.6.7.8"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"9.9.9.9", "5.6.7.8, 127.0.0.1"}, trusted: trusted, e: "5.6.7.8"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"10.0.0.3, 10.0.0.2"}, trusted: trusted, e: "10.0.0.3"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"5.6.7.8, foo"}, trusted: trusted, e: "10.0.0.1"},
+		{remote: "10.0.0.1:1234", trusted: trusted, e: "10.0.0.1"},
+	} {
+		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
+			r := &http.Request{RemoteAddr: tc.remote, Header: http.Header{}}
+			for _, f := range tc.forwarded {
+				r.Header.Add("X-Forwarded-For", f)
+			}
+			assert.Equal(t, tc.e, ClientIP(r, tc.trusted))
+		})
+	}
+}
@@ -14,6 +14,12 @@ var PseudoPanic = herodot.DefaultError{
 	CodeField:   http.StatusConflict,
 }
 
+var ErrPreconditionFailed = herodot.DefaultError{
+	StatusField: http.StatusText(http.StatusPreconditionFailed),
+	ErrorField:  "The resource has been modified and no longer matches the given precondition",
+	CodeField:   http.StatusPreconditionFailed,
+}
+
 type StatusCodeCarrier interface {
 	StatusCode() int
 }
@@ -1,15 +1,24 @@
 package x
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
 	"github.com/ory/x/pagination/pagepagination"
 )
 
 const paginationMaxItems = 1000
 const paginationDefaultItems = 250
 
+// PageTokenQueryParameter is the query parameter carrying the keyset pagination token.
+const PageTokenQueryParameter = "page_token"
+
 var paginator = &pagepagination.PagePaginator{
 	MaxItems:     paginationMaxItems,
 	DefaultItems: paginationDefaultItems,
@@ -23,3 +32,84 @@ func ParsePagination(r *http.Request) (page, itemsPerPage int) {
 func PaginationHeader(w http.ResponseWriter, u *url.URL, total int64, page, itemsPerPage int) {
 	pagepagination.PaginationHeader(w, u, total, page, itemsPerPage)
 }
+
+// Page describes a page of a list which is ordered by descending ID.
+//
+// Lists are paginated using keyset pagination: the token is the ID of the last item of the previous
+// page and only items with a smaller ID are returned. Offset pagination using the `page` query
+// parameter is still supported but deprecated because it gets slow and inconsistent on large tables.
+type Page struct {
+	// Token is the ID of the last item of the previous page. It is uuid.Nil for the first page.
+	Token uuid.UUID
+
+	// Offset is the deprecated zero-based page number. It is only used if UseOffset is true.
+	Offset int
+
+	// UseOffset is true if the deprecated offset pagination was requested.
+	UseOffset bool
+
+	// ItemsPerPage is the maximum number of items on the page.
+	ItemsPerPage int
+}
+
+// KeysetPage returns the first page of a keyset paginated list.
+func KeysetPage(itemsPerPage int) Page {
+	return Page{ItemsPerPage: itemsPerPage}
+}
+
+// OffsetPage returns a page of an offset paginated list. Offset pagination is deprecated, prefer
+// KeysetPage and Page.Next.
+func OffsetPage(page, itemsPerPage int) Page {
+	return Page{Offset: page, UseOffset: true, ItemsPerPage: itemsPerPage}
+}
+
+// Next returns the page following the given last item ID.
+func (p Page) Next(last uuid.UUID) Page {
+	return Page{Token: last, ItemsPerPage: p.ItemsPerPage}
+}
+
+// ParsePage parses the `page_token`, `per_page`, and the deprecated `page` query parameters.
+//
+// If both `page_token` and `page` are set, `page_token` wins.
+func ParsePage(r *http.Request) (Page, error) {
+	page, itemsPerPage := paginator.ParsePagination(r)
+
+	query := r.URL.Query()
+	if token := query.Get(PageTokenQueryParameter); token != "" {
+		id, err := uuid.FromString(token)
+		if err != nil {
+			return Page{}, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Parameter `%s` is not a valid page token.", PageTokenQueryParameter))
+		}
+		return Page{Token: id, ItemsPerPage: itemsPerPage}, nil
+	}
+
+	if query.Get("page") != "" {
+		return OffsetPage(page, itemsPerPage), nil
+	}
+
+	return KeysetPage(itemsPerPage), nil
+}
+
+func keysetHeader(u *url.URL, rel string, itemsPerPage int, token uuid.UUID) string {
+	q := u.Query()
+	q.Del("page")
+	q.Del(PageTokenQueryParameter)
+	q.Set("per_page", strconv.Itoa(itemsPerPage))
+	if token != uuid.Nil {
+		q.Set(PageTokenQueryParameter, token.String())
+	}
+
+	next := *u
+	next.RawQuery = q.Encode()
+	return fmt.Sprintf("<%s>; rel=\"%s\"", next.String(), rel)
+}
+
+// KeysetPaginationHeader sets the Link header for a keyset paginated list. The `next` link is only
+// added if the page is full, in which case lastID must be the ID of the last item on the page.
+func KeysetPaginationHeader(w http.ResponseWriter, u *url.URL, p Page, items int, lastID uuid.UUID) {
+	links := keysetHeader(u, "first", p.ItemsPerPage, uuid.Nil)
+	if items >= p.ItemsPerPage && lastID != uuid.Nil {
+		links += "," + keysetHeader(u, "next", p.ItemsPerPage, lastID)
+	}
+	w.Header().Set("Link", links)
+}
@@ -0,0 +1,66 @@
+package x
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/herodot"
+)
+
+func TestParsePage(t *testing.T) {
+	id := NewUUID()
+
+	for k, tc := range []struct {
+		query    string
+		expected Page
+	}{
+		{query: "", expected: Page{ItemsPerPage: paginationDefaultItems}},
+		{query: "per_page=10", expected: Page{ItemsPerPage: 10}},
+		{query: "per_page=10&page_token=" + id.String(), expected: Page{Token: id, ItemsPerPage: 10}},
+		{query: "per_page=10&page=2", expected: Page{Offset: 2, UseOffset: true, ItemsPerPage: 10}},
+		{query: "page=2&page_token=" + id.String(), expected: Page{Token: id, ItemsPerPage: paginationDefaultItems}},
+	} {
+		t.Run("case="+tc.query, func(t *testing.T) {
+			actual, err := ParsePage(httptest.NewRequest("GET", "/?"+tc.query, nil))
+			require.NoError(t, err, "%d", k)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("case=invalid token", func(t *testing.T) {
+		_, err := ParsePage(httptest.NewRequest("GET", "/?page_token=foo", nil))
+		require.ErrorIs(t, err, herodot.ErrBadRequest)
+	})
+}
+
+func TestKeysetPaginationHeader(t *testing.T) {
+	u, err := url.Parse("https://example.org/identities?page=2&state=active")
+	require.NoError(t, err)
+	last := NewUUID()
+
+	t.Run("case=full page", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		KeysetPaginationHeader(w, u, KeysetPage(2), 2, last)
+		assert.Equal(t, `<https://example.org/identities?per_page=2&state=active>; rel="first",<https://example.org/identities?page_token=`+last.String()+`&per_page=2&state=active>; rel="next"`, w.Header().Get("Link"))
+		assert.Empty(t, w.Header().Get("X-Total-Count"))
+	})
+
+	t.Run("case=last page", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		KeysetPaginationHeader(w, u, KeysetPage(2), 1, last)
+		assert.Equal(t, `<https://example.org/identities?per_page=2&state=active>; rel="first"`, w.Header().Get("Link"))
+	})
+
+	t.Run("case=empty page", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		KeysetPaginationHeader(w, u, KeysetPage(2), 0, uuid.Nil)
+		assert.Equal(t, `<https://example.org/identities?per_page=2&state=active>; rel="first"`, w.Header().Get("Link"))
+	})
+
+	assert.Equal(t, "page=2&state=active", u.RawQuery, "the URL must not be modified")
+}
@@ -39,7 +39,10 @@ func CleanSQL(t *testing.T, c *pop.Connection) {
 
 		new(errorx.ErrorContainer).TableName(ctx),
 
+		new(session.Device).TableName(ctx),
 		new(session.Session).TableName(ctx),
+		new(identity.HistoryEntry).TableName(ctx),
+		new(identity.UniqueTrait).TableName(ctx),
 		new(identity.CredentialIdentifierCollection).TableName(ctx),
 		new(identity.CredentialsCollection).TableName(ctx),
 		new(identity.VerifiableAddress).TableName(ctx),